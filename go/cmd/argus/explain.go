@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/service"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/utils/color"
+)
+
+// explainResource는 --explain으로 지정한 리소스의 분류 결과와 결정 규칙을 출력한다
+func explainResource(svc *service.ScannerService, target string) {
+	namespace, kind, name, err := parseExplainTarget(target)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	explanations, err := svc.ExplainResource(context.Background(), namespace, kind, name)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	for _, explanation := range explanations {
+		printExplanation(explanation)
+	}
+}
+
+func parseExplainTarget(target string) (string, string, string, error) {
+	parts := strings.Split(target, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("--explain 형식이 잘못되었습니다: %s (<네임스페이스>/<Kind>/<이름>)", target)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func printExplanation(explanation service.Explanation) {
+	resource := explanation.Resource
+	id := resource.Identifier
+
+	fmt.Printf("\n%s🔍 %s/%s/%s%s (%s)\n", color.Bold, id.Namespace, id.Kind, id.Name, color.NC, id.APIVersion)
+	fmt.Printf("  분류: %s%s%s\n", color.Cyan, explanation.Classification.Label(), color.NC)
+
+	switch {
+	case resource.DecidedBy != nil:
+		fmt.Printf("  결정 규칙: %s\n", resource.DecidedBy)
+	case explanation.Classification == domain.ClassificationManual:
+		fmt.Println("  결정 규칙: 일치하는 규칙 없음 (exclusions, patterns, auto_managed, argocd, managers 어디에도 해당하지 않음)")
+	}
+
+	if explanation.Classification == domain.ClassificationChild {
+		for _, owner := range resource.OwnerReferences {
+			if ref, ok := owner.(map[string]interface{}); ok {
+				fmt.Printf("  소유자: %v/%v\n", ref["kind"], ref["name"])
+			}
+		}
+	}
+	if resource.Manager != "" {
+		fmt.Printf("  관리 도구: %s\n", resource.Manager)
+	}
+	if managers := resource.FieldManagers(); len(managers) > 0 {
+		fmt.Printf("  필드 매니저: %s\n", strings.Join(managers, ", "))
+	}
+}
@@ -1,18 +1,27 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"regexp"
 	"sort"
 	"strings"
+	"syscall"
 	"time"
 
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/baseline"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/gate"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/history"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/reporter"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/service"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/client"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/dump"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/utils/color"
 )
 
@@ -28,21 +37,94 @@ type CLIFlags struct {
 	GenerateImage *bool
 	Timeout       *int
 	Retry         *int
+	Backoff       *time.Duration
+	BackoffMax    *time.Duration
+	QPS           *float64
+	Burst         *int
+	MaxInFlight   *int
+	CacheDir      *string
+	CacheTTL      *time.Duration
+	NSCacheTTL    *time.Duration
+	RefreshCache  *bool
+	OutputFormat  *string
+	OutputDir     *string
+	OutputName    *string
+	FailOnManual  *bool
+	MaxManual     *int
+	MaxManualNS   *int
+	FailKinds     *string
+
+	MaxManualCluster *int
+
+	AllowIncomplete *bool
+
+	Baseline        *string
+	WriteBaseline   *bool
+	BaselineReason  *string
+	BaselineOwner   *string
+	BaselineExpires *string
+
+	Compare *string
+
+	ClusterScope *bool
+	FromDump     *string
+	Snapshot     *string
+
+	SnapshotKeepSecrets *bool
+
+	Explain      *string
+	ShowRules    *bool
+	ShowExcluded *bool
+
+	ExportManifests     *bool
+	ExportKustomization *bool
+	ExportSecrets       *string
 }
 
+// 128 + SIGINT, 셸 관례에 따라 중단된 실행을 나타낸다
+const exitCodeInterrupted = 130
+
+var supportedOutputFormats = []string{"console", "markdown", "html", "json", "image"}
+
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "config":
+			runConfigCommand(os.Args[2:])
+			return
+		case "remediate":
+			runRemediateCommand(os.Args[2:])
+			return
+		}
+	}
+
 	flags := parseCommandLineFlags()
 	startTime := time.Now()
 
 	cfg := loadConfiguration(flags)
 	applyPerformanceSettings(cfg, flags)
-
-	k8sClient := createKubernetesClient(cfg)
+	cfg.ScanClusterScope = *flags.ClusterScope
+
+	k8sClient := createKubernetesClient(cfg, flags)
+	apiClient := k8sClient
+	var recorder *dump.Recorder
+	if *flags.Snapshot != "" {
+		recorder = dump.NewRecorder(k8sClient)
+		recorder.SetKeepSecretData(*flags.SnapshotKeepSecrets)
+		k8sClient = recorder
+	}
 	svc := createAnalysisService(cfg, k8sClient, flags)
 
-	context, cluster := svc.GetCurrentContext()
-	displayApplicationHeader(context, cluster)
-	displayAPISettings(*flags.Timeout, *flags.Retry)
+	kubeContext, cluster := svc.GetCurrentContext()
+	displayApplicationHeader(kubeContext, cluster)
+	if *flags.FromDump == "" {
+		displayAPISettings(flags)
+	}
+
+	if *flags.Explain != "" {
+		explainResource(svc, *flags.Explain)
+		return
+	}
 
 	namespaces := resolveTargetNamespaces(svc, cfg, flags)
 	validNamespaces := validateNamespaces(svc, namespaces)
@@ -51,7 +133,35 @@ func main() {
 		confirmExecutionOrExit(validNamespaces)
 	}
 
-	executeResourceAnalysis(svc, validNamespaces, flags, context, cluster, startTime)
+	run := domain.RunInfo{Context: kubeContext, Cluster: cluster, StartTime: startTime}
+	allResults, run := executeResourceAnalysis(svc, validNamespaces, flags, run)
+	reportAPIThrottling(apiClient)
+	reportDiscoveryFailures(run.DiscoveryFailures)
+	if recorder != nil {
+		writeSnapshot(recorder, run, *flags.Snapshot)
+	}
+
+	if *flags.WriteBaseline {
+		writeBaseline(allResults, run, flags)
+	}
+	if *flags.Baseline != "" {
+		allResults = applyBaseline(allResults, flags)
+		run.BaselineFile = *flags.Baseline
+	}
+	if *flags.Compare != "" {
+		run.Comparison = compareWithPreviousRun(allResults, run, *flags.Compare)
+	}
+
+	generateReports(svc, allResults, run)
+
+	if run.Partial {
+		printWarning("스캔이 중단되어 부분 리포트만 생성했습니다")
+		os.Exit(exitCodeInterrupted)
+	}
+
+	if *flags.FailOnManual {
+		enforceManualResourceGate(allResults, flags)
+	}
 }
 
 func parseCommandLineFlags() *CLIFlags {
@@ -65,19 +175,100 @@ func parseCommandLineFlags() *CLIFlags {
 		BatchSize:     flag.Int("batch-size", 0, "리소스 타입 배치 크기 (0=자동)"),
 		FastScan:      flag.Bool("fast", false, "빠른 스캔 모드 (중요 리소스만 검사)"),
 		GenerateImage: flag.Bool("image", false, "이미지 파일 생성"),
-		Timeout:       flag.Int("timeout", 30, "API 요청 타임아웃 (초)"),
-		Retry:         flag.Int("retry", 3, "타임아웃 시 재시도 횟수"),
+		Timeout:       flag.Int("timeout", int(client.DefaultTimeout/time.Second), "API 요청 타임아웃 (초)"),
+		Retry:         flag.Int("retry", client.DefaultMaxAttempts, "타임아웃 시 최대 시도 횟수 (첫 시도 포함)"),
+		Backoff:       flag.Duration("backoff", client.DefaultBackoffBase, "재시도 대기 시간 기본값 (재시도마다 2배 증가)"),
+		BackoffMax:    flag.Duration("backoff-max", client.DefaultBackoffMax, "재시도 대기 시간 최대값"),
+		QPS:           flag.Float64("qps", client.DefaultQPS, "초당 API 요청 수 제한 (음수=제한 없음)"),
+		Burst:         flag.Int("burst", client.DefaultBurst, "순간적으로 허용할 API 요청 수"),
+		MaxInFlight:   flag.Int("max-inflight", client.DefaultMaxInFlight, "동시 목록 조회 최대 수 (API 서버가 429로 거부하면 자동으로 줄임)"),
+		CacheDir:      flag.String("cache-dir", "", "discovery 결과와 네임스페이스 목록을 클러스터별로 저장할 디렉토리 (비어 있으면 사용 안 함)"),
+		CacheTTL:      flag.Duration("cache-ttl", client.DefaultDiscoveryCacheTTL, "discovery 캐시 유효 기간"),
+		NSCacheTTL:    flag.Duration("namespace-cache-ttl", client.DefaultNamespaceCacheTTL, "네임스페이스 목록 캐시 유효 기간"),
+		RefreshCache:  flag.Bool("refresh-cache", false, "저장된 캐시를 무시하고 새로 조회 (CRD 추가 직후 등)"),
+		OutputFormat:  flag.String("o", "console,markdown,html", "출력 형식 (console,markdown,html,json,image)"),
+		OutputDir:     flag.String("output-dir", "reports", "보고서 저장 디렉토리"),
+		OutputName:    flag.String("output-name", reporter.DefaultFileNameTemplate, "보고서 파일명 템플릿 ({timestamp},{date},{time},{context},{cluster})"),
+		FailOnManual:  flag.Bool("fail-on-manual", false, "수동 리소스가 임계값을 넘으면 종료 코드 2로 실패 (CI 용)"),
+		MaxManual:     flag.Int("max-manual", gate.Unlimited, "허용할 전체 수동 리소스 수 (-1=검사 안 함, 조건 미지정 시 0)"),
+		MaxManualNS:   flag.Int("max-manual-per-ns", gate.Unlimited, "네임스페이스별 허용 수동 리소스 수 (-1=검사 안 함)"),
+		FailKinds:     flag.String("fail-kinds", "", "수동 생성을 허용하지 않는 Kind 목록 (예: Secret,Deployment)"),
+
+		MaxManualCluster: flag.Int("max-manual-cluster", gate.Unlimited, "클러스터 범위(--cluster-scope)의 허용 수동 리소스 수 (-1=검사 안 함)"),
+
+		AllowIncomplete: flag.Bool("allow-incomplete", false, "일부 리소스 타입을 조회하지 못한 불완전한 스캔도 게이트를 통과할 수 있게 허용"),
+
+		Baseline:        flag.String("baseline", "", "베이스라인 파일 경로 (등록된 수동 리소스는 리포트에서 제외)"),
+		WriteBaseline:   flag.Bool("write-baseline", false, "현재 수동 리소스를 베이스라인 파일에 기록 (기본 파일: "+baseline.DefaultFile+")"),
+		BaselineReason:  flag.String("baseline-reason", "", "베이스라인에 새로 기록할 항목의 수용 사유 (--write-baseline 시 필수)"),
+		BaselineOwner:   flag.String("baseline-owner", "", "베이스라인에 새로 기록할 항목의 담당자 (기본: $USER)"),
+		BaselineExpires: flag.String("baseline-expires", "", "베이스라인에 새로 기록할 항목의 만료일 (YYYY-MM-DD)"),
+
+		Compare: flag.String("compare", "", "비교할 이전 JSON 리포트 파일 또는 디렉토리 (디렉토리면 가장 최근 리포트)"),
+
+		ClusterScope: flag.Bool("cluster-scope", false, "클러스터 범위 리소스(ClusterRole, CRD, StorageClass 등)도 검사"),
+		FromDump:     flag.String("from-dump", "", "API 서버 대신 매니페스트 덤프 파일 또는 디렉토리를 분석 (kubectl get -A -o yaml 출력, Velero 백업 등)"),
+		Explain:      flag.String("explain", "", "리소스 하나의 분류 결과와 결정한 규칙을 출력 (<네임스페이스>/<Kind>/<이름>, 클러스터 범위는 (cluster)/<Kind>/<이름>)"),
+		ShowExcluded: flag.Bool("show-excluded", false, "Markdown/HTML 리포트에 제외된 리소스와 제외 규칙 감사 섹션 추가 (HTML은 접힌 상태), JSON 리포트에 excludedResourceList 기록"),
+		ShowRules:    flag.Bool("show-rules", false, "Markdown/HTML 리포트의 리소스 목록에 분류를 결정한 규칙 열 추가"),
+		Snapshot:     flag.String("snapshot", "", "조회한 객체와 리소스 타입 목록을 디렉토리에 tar.gz 아카이브로 저장 (--from-dump로 재분석)"),
+
+		SnapshotKeepSecrets: flag.Bool("snapshot-keep-secrets", false, "스냅샷에 Secret 값을 지우지 않고 그대로 저장"),
+
+		ExportManifests:     flag.Bool("export-manifests", false, "수동 리소스를 GitOps 저장소에 커밋할 수 있는 YAML로 내보내기 (<출력 디렉토리>/<파일명>-manifests/<네임스페이스>/<kind>-<이름>.yaml)"),
+		ExportKustomization: flag.Bool("export-kustomization", false, "내보낸 네임스페이스 디렉토리마다 kustomization.yaml 생성"),
+		ExportSecrets:       flag.String("export-secrets", string(reporter.SecretSealed), "Secret 내보내기 방식 (sealed-secret, external-secret, skip, plain=값 그대로)"),
 	}
 	flag.Parse()
+	validateBaselineFlags(flags)
+	validateExportFlags(flags)
+	validateOutputFlags(flags)
 	return flags
 }
 
+func validateBaselineFlags(flags *CLIFlags) {
+	if !*flags.WriteBaseline {
+		return
+	}
+
+	if *flags.Baseline == "" {
+		*flags.Baseline = baseline.DefaultFile
+	}
+	if strings.TrimSpace(*flags.BaselineReason) == "" {
+		exitWithError("--write-baseline 사용 시 --baseline-reason으로 수용 사유를 지정해야 합니다")
+	}
+	if *flags.BaselineOwner == "" {
+		*flags.BaselineOwner = os.Getenv("USER")
+	}
+	if err := baseline.ValidateExpires(*flags.BaselineExpires); err != nil {
+		exitWithError("--baseline-expires: %v", err)
+	}
+}
+
+func validateExportFlags(flags *CLIFlags) {
+	if _, err := reporter.ParseSecretMode(*flags.ExportSecrets); err != nil {
+		exitWithError("--export-secrets: %v", err)
+	}
+	if !*flags.ExportManifests && (*flags.ExportKustomization || isFlagSet("export-secrets")) {
+		printWarning("--export-kustomization, --export-secrets는 --export-manifests와 함께 사용해야 적용됩니다")
+	}
+}
+
+func validateOutputFlags(flags *CLIFlags) {
+	if err := reporter.ValidateFileNameTemplate(*flags.OutputName); err != nil {
+		exitWithError("--output-name: %v", err)
+	}
+}
+
 func loadConfiguration(flags *CLIFlags) *config.Config {
 	cfg, err := config.LoadConfigFromFile(*flags.ConfigFile)
 	if err != nil {
 		exitWithError("설정 파일 로드 실패 (%s): %v", *flags.ConfigFile, err)
 	}
 	printSuccess("설정 파일 로드됨: %s", *flags.ConfigFile)
+	for _, warning := range cfg.Warnings {
+		printWarning("%s (argus config validate -f %s로 확인하세요)", warning, *flags.ConfigFile)
+	}
 	return cfg
 }
 
@@ -85,6 +276,9 @@ func applyPerformanceSettings(cfg *config.Config, flags *CLIFlags) {
 	if *flags.BatchSize > 0 {
 		cfg.BatchSize = *flags.BatchSize
 	}
+	if !isFlagSet("P") {
+		*flags.Parallel = cfg.MaxConcurrent()
+	}
 
 	if *flags.FastScan {
 		enableFastScanMode(cfg, flags)
@@ -95,16 +289,43 @@ func applyPerformanceSettings(cfg *config.Config, flags *CLIFlags) {
 
 func enableFastScanMode(cfg *config.Config, flags *CLIFlags) {
 	printInfo("⚡ 빠른 스캔 모드 활성화 (중요 리소스 %d개만 검사)", len(cfg.ImportantResourceTypes))
-	if *flags.Parallel == config.DefaultMaxConcurrent {
+	if !isFlagSet("P") && cfg.Performance.FastScanConcurrent > 0 {
 		*flags.Parallel = cfg.Performance.FastScanConcurrent
 	}
 }
 
-func createKubernetesClient(cfg *config.Config) *client.Client {
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func createKubernetesClient(cfg *config.Config, flags *CLIFlags) k8sinterface.K8sClient {
+	if *flags.FromDump != "" {
+		return createDumpClient(cfg, *flags.FromDump)
+	}
+
 	printInfo("🚀 Kubernetes Go Client 사용")
 	clientConfig := &client.ClientConfig{
 		ImportantResourceTypes: cfg.ImportantResourceTypes,
 		SkipResourceTypes:      cfg.SkipResourceTypes,
+		Timeout:                time.Duration(*flags.Timeout) * time.Second,
+		MaxAttempts:            *flags.Retry,
+		BackoffBase:            *flags.Backoff,
+		BackoffMax:             *flags.BackoffMax,
+		QPS:                    float32(*flags.QPS),
+		Burst:                  *flags.Burst,
+		MaxInFlight:            *flags.MaxInFlight,
+		CacheDir:               *flags.CacheDir,
+		DiscoveryCacheTTL:      *flags.CacheTTL,
+		NamespaceCacheTTL:      *flags.NSCacheTTL,
+		RefreshCache:           *flags.RefreshCache,
+		// 분류에는 메타데이터만 필요하다. 매니페스트 내보내기와 스냅샷은 객체 본문이 필요하다.
+		MetadataOnly: !*flags.ExportManifests && *flags.Snapshot == "",
 	}
 	k8sClient, err := client.NewClient(clientConfig)
 	if err != nil {
@@ -113,28 +334,147 @@ func createKubernetesClient(cfg *config.Config) *client.Client {
 	return k8sClient
 }
 
-func createAnalysisService(cfg *config.Config, k8sClient *client.Client, flags *CLIFlags) *service.ScannerService {
+func createDumpClient(cfg *config.Config, path string) k8sinterface.K8sClient {
+	dumpClient, err := dump.NewClient(path, &dump.ClientConfig{
+		ImportantResourceTypes: cfg.ImportantResourceTypes,
+		SkipResourceTypes:      cfg.SkipResourceTypes,
+	})
+	if err != nil {
+		exitWithError("덤프 로드 실패: %v", err)
+	}
+	printInfo("📂 매니페스트 덤프 분석: %s", path)
+	return dumpClient
+}
+
+func writeSnapshot(recorder *dump.Recorder, run domain.RunInfo, dir string) {
+	path, err := recorder.WriteArchive(dir, run.StartTime)
+	if err != nil {
+		printWarning("스냅샷 저장 실패: %v", err)
+		return
+	}
+	if run.Partial {
+		printWarning("스캔이 중단되어 스냅샷에 일부 리소스만 포함되었습니다")
+	}
+	printSuccess("스냅샷 저장됨: %s", path)
+	if recorder.KeepsSecretData() {
+		printWarning("스냅샷에 Secret 값이 평문으로 포함되어 있습니다. 공유하거나 이슈에 첨부하지 마세요")
+	}
+}
+
+func createAnalysisService(cfg *config.Config, k8sClient k8sinterface.K8sClient, flags *CLIFlags) *service.ScannerService {
 	svc := service.NewScannerService(cfg, k8sClient)
 
-	svc.AddReporter(reporter.NewConsoleReporter())
-	svc.AddReporter(reporter.NewMarkdownReporter("reports"))
-	svc.AddReporter(reporter.NewHTMLReporter("reports"))
+	formats := parseOutputFormats(flags)
+	outputDir := *flags.OutputDir
+	outputName := *flags.OutputName
 
-	if *flags.GenerateImage {
-		svc.AddReporter(reporter.NewImageReporter("reports"))
+	// 이미지 리포터는 HTML 파일을 변환하므로 항상 HTML 이후에 등록한다
+	for _, format := range supportedOutputFormats {
+		if !formats[format] {
+			continue
+		}
+		switch format {
+		case "console":
+			svc.AddReporter(reporter.NewConsoleReporter())
+		case "markdown":
+			markdownReporter := reporter.NewMarkdownReporter(outputDir, outputName)
+			markdownReporter.SetShowDecisionRules(*flags.ShowRules)
+			markdownReporter.SetShowExcluded(*flags.ShowExcluded)
+			svc.AddReporter(markdownReporter)
+		case "html":
+			htmlReporter := reporter.NewHTMLReporter(outputDir, outputName)
+			htmlReporter.SetShowDecisionRules(*flags.ShowRules)
+			htmlReporter.SetShowExcluded(*flags.ShowExcluded)
+			svc.AddReporter(htmlReporter)
+		case "json":
+			jsonReporter := reporter.NewJSONReporter(outputDir, outputName, cfg.SourceFile, cfg.SourceHash)
+			jsonReporter.SetShowExcluded(*flags.ShowExcluded)
+			svc.AddReporter(jsonReporter)
+		case "image":
+			svc.AddReporter(reporter.NewImageReporter(outputDir, outputName))
+		}
+	}
+
+	if *flags.ExportManifests {
+		secretMode, _ := reporter.ParseSecretMode(*flags.ExportSecrets)
+		manifestExporter := reporter.NewManifestExporter(outputDir, outputName)
+		manifestExporter.SetKustomization(*flags.ExportKustomization)
+		manifestExporter.SetSecretMode(secretMode)
+		svc.AddReporter(manifestExporter)
 	}
 
 	return svc
 }
 
+func parseOutputFormats(flags *CLIFlags) map[string]bool {
+	supported := make(map[string]bool)
+	for _, format := range supportedOutputFormats {
+		supported[format] = true
+	}
+
+	formats := make(map[string]bool)
+	for _, format := range strings.Split(*flags.OutputFormat, ",") {
+		format = strings.ToLower(strings.TrimSpace(format))
+		if format == "" {
+			continue
+		}
+		if !supported[format] {
+			exitWithError("지원하지 않는 출력 형식: %s (지원: %s)", format, strings.Join(supportedOutputFormats, ","))
+		}
+		formats[format] = true
+	}
+
+	if *flags.GenerateImage {
+		formats["image"] = true
+	}
+
+	if formats["image"] && !formats["html"] {
+		printWarning("이미지 생성을 위해 HTML 리포트를 함께 생성합니다")
+		formats["html"] = true
+	}
+
+	return formats
+}
+
 func displayApplicationHeader(context, cluster string) {
 	fmt.Printf("%s🚀 Argus - Kubernetes 리소스 분석기%s\n", color.Bold, color.NC)
 	fmt.Printf("현재 컨텍스트: %s%s%s\n", color.Cyan, context, color.NC)
 	fmt.Printf("클러스터: %s%s%s\n", color.Cyan, cluster, color.NC)
 }
 
-func displayAPISettings(timeout, retry int) {
-	fmt.Printf("%s⚙️  API 타임아웃: %d초, 재시도: %d회%s\n", color.Cyan, timeout, retry, color.NC)
+func displayAPISettings(flags *CLIFlags) {
+	fmt.Printf("%s⚙️  API 타임아웃: %d초, 최대 시도: %d회 (재시도 대기 %s ~ %s)%s\n",
+		color.Cyan, *flags.Timeout, *flags.Retry, *flags.Backoff, *flags.BackoffMax, color.NC)
+	if *flags.QPS < 0 {
+		fmt.Printf("%s⚙️  요청 제한: 없음, 동시 조회 최대 %d개%s\n", color.Cyan, *flags.MaxInFlight, color.NC)
+		return
+	}
+	fmt.Printf("%s⚙️  요청 제한: 초당 %g개 (버스트 %d), 동시 조회 최대 %d개%s\n",
+		color.Cyan, *flags.QPS, *flags.Burst, *flags.MaxInFlight, color.NC)
+}
+
+// reportDiscoveryFailures는 discovery에 응답하지 않아 스캔에서 빠진 API 그룹을 알린다
+func reportDiscoveryFailures(failures []domain.ResourceTypeFailure) {
+	if len(failures) == 0 {
+		return
+	}
+	printWarning("discovery에 실패한 API 그룹 %d개는 스캔하지 않았습니다 (모든 네임스페이스를 불완전한 스캔으로 기록):", len(failures))
+	for _, failure := range failures {
+		fmt.Printf("  - %s: %s\n", failure.ResourceType, failure.Message)
+	}
+}
+
+// reportAPIThrottling은 스캔 중 API 서버가 요청을 거부(429)해 동시 조회 수를 줄였는지 알린다
+func reportAPIThrottling(k8sClient k8sinterface.K8sClient) {
+	throttler, ok := k8sClient.(interface{ ThrottleStats() (int, int) })
+	if !ok {
+		return
+	}
+	limit, throttled := throttler.ThrottleStats()
+	if throttled == 0 {
+		return
+	}
+	printWarning("API 서버가 요청 %d건을 제한(429)했습니다. 동시 조회 수를 %d개로 줄였습니다 (--qps, --max-inflight로 조정)", throttled, limit)
 }
 
 func resolveTargetNamespaces(svc *service.ScannerService, cfg *config.Config, flags *CLIFlags) []string {
@@ -146,7 +486,7 @@ func resolveTargetNamespaces(svc *service.ScannerService, cfg *config.Config, fl
 }
 
 func validateNamespaces(svc *service.ScannerService, namespaces []string) []string {
-	validNamespaces, err := svc.ValidateNamespaces(namespaces)
+	validNamespaces, err := svc.ValidateNamespaces(context.Background(), namespaces)
 	if err != nil {
 		exitWithError("%v", err)
 	}
@@ -164,21 +504,131 @@ func confirmExecutionOrExit(namespaces []string) {
 	}
 }
 
-func executeResourceAnalysis(svc *service.ScannerService, namespaces []string, flags *CLIFlags, context, cluster string, startTime time.Time) {
+func executeResourceAnalysis(svc *service.ScannerService, namespaces []string, flags *CLIFlags, run domain.RunInfo) (map[string]domain.AnalysisResult, domain.RunInfo) {
 	maxConcurrent := limitConcurrency(*flags.Parallel)
 
-	printInfo("⏳ 리소스 검사 시작... (동시 처리: %d)", maxConcurrent)
+	printInfo("⏳ 리소스 검사 시작... (동시 처리: %d, Ctrl-C로 중단 시 완료된 결과로 리포트 생성)", maxConcurrent)
+
+	scanCtx, stopScan := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	allResults, err := svc.AnalyzeNamespaces(scanCtx, namespaces, maxConcurrent)
+	// AnalyzeNamespaces가 끝난 뒤 stopScan 전에 받은 신호는 중단으로 보지 않는다
+	interrupted := err != nil && scanCtx.Err() != nil
+	stopScan()
+
+	if err != nil && !interrupted {
+		exitWithError("%v", err)
+	}
+	run.DiscoveryFailures = svc.DiscoveryFailures()
+
+	// 모든 네임스페이스 분석이 끝난 뒤에 받은 신호는 결과에 영향이 없으므로 실제로 빠진 범위가 있을 때만 부분 결과로 본다
+	run.SkippedNamespaces = findSkippedNamespaces(namespaces, allResults)
+	if interrupted && *flags.ClusterScope {
+		if _, ok := allResults[domain.ClusterScope]; !ok {
+			run.SkippedNamespaces = append(run.SkippedNamespaces, domain.ClusterScope)
+		}
+	}
+	run.Partial = len(run.SkippedNamespaces) > 0
+
+	return allResults, run
+}
+
+func writeBaseline(allResults map[string]domain.AnalysisResult, run domain.RunInfo, flags *CLIFlags) {
+	// 중단된 스캔으로 기록하면 분석하지 못한 네임스페이스의 항목 상태를 알 수 없다
+	if run.Partial {
+		printWarning("스캔이 중단되어 베이스라인을 기록하지 않습니다")
+		return
+	}
+
+	b, err := baseline.LoadOrEmpty(*flags.Baseline)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	acceptance := baseline.Acceptance{
+		Reason:  *flags.BaselineReason,
+		Owner:   *flags.BaselineOwner,
+		Expires: *flags.BaselineExpires,
+	}
+	added := b.Record(allResults, acceptance, time.Now())
+
+	if err := b.Save(*flags.Baseline); err != nil {
+		exitWithError("%v", err)
+	}
+	printSuccess("베이스라인 저장됨: %s (전체 %d개, 신규 %d개)", *flags.Baseline, len(b.Entries), added)
+}
 
-	allResults, err := svc.AnalyzeNamespaces(namespaces, maxConcurrent)
+func applyBaseline(allResults map[string]domain.AnalysisResult, flags *CLIFlags) map[string]domain.AnalysisResult {
+	b, err := baseline.Load(*flags.Baseline)
 	if err != nil {
 		exitWithError("%v", err)
 	}
+	printInfo("📌 베이스라인 적용: %s (%d개 항목)", *flags.Baseline, len(b.Entries))
+	return b.Apply(allResults, time.Now())
+}
+
+// 이전 리포트를 읽지 못해도 이번 스캔 결과는 그대로 리포트한다
+func compareWithPreviousRun(allResults map[string]domain.AnalysisResult, run domain.RunInfo, path string) *domain.ScanComparison {
+	reportPath, err := history.ResolveReportPath(path)
+	if err != nil {
+		printWarning("이전 실행과 비교하지 않습니다: %v", err)
+		return nil
+	}
 
-	if err := svc.GenerateReports(allResults, context, cluster, startTime); err != nil {
+	snapshot, err := history.Load(reportPath)
+	if err != nil {
+		printWarning("이전 실행과 비교하지 않습니다: %v", err)
+		return nil
+	}
+
+	previous := snapshot.Report.Metadata
+	if previous.Context != run.Context || previous.Cluster != run.Cluster {
+		printWarning("이전 리포트의 컨텍스트/클러스터가 다릅니다: %s / %s", previous.Context, previous.Cluster)
+	}
+	if previous.Partial {
+		printWarning("이전 리포트는 중단된 스캔의 부분 결과입니다")
+	}
+
+	comparison := snapshot.Compare(allResults)
+	printInfo("🔄 이전 실행과 비교: %s (수동 리소스 %d개 → %d개)", reportPath, comparison.PreviousManual, comparison.CurrentManual)
+	return &comparison
+}
+
+func generateReports(svc *service.ScannerService, allResults map[string]domain.AnalysisResult, run domain.RunInfo) {
+	// 리포트 생성 중 다시 신호를 받으면 리포트 생성도 중단한다
+	reportCtx, stopReport := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopReport()
+
+	if err := svc.GenerateReports(reportCtx, allResults, run); err != nil {
 		exitWithError("보고서 생성 실패: %v", err)
 	}
 }
 
+func findSkippedNamespaces(namespaces []string, allResults map[string]domain.AnalysisResult) []string {
+	var skipped []string
+	for _, ns := range namespaces {
+		if _, ok := allResults[ns]; !ok {
+			skipped = append(skipped, ns)
+		}
+	}
+	sort.Strings(skipped)
+	return skipped
+}
+
+func enforceManualResourceGate(allResults map[string]domain.AnalysisResult, flags *CLIFlags) {
+	thresholds := gate.NewThresholds(*flags.MaxManual, *flags.MaxManualNS, gate.ParseKinds(*flags.FailKinds))
+	thresholds.MaxClusterScope = *flags.MaxManualCluster
+	thresholds.AllowIncomplete = *flags.AllowIncomplete
+	verdict := gate.Evaluate(allResults, thresholds)
+
+	if verdict.Passed {
+		printSuccess("%s", verdict.Summary())
+		return
+	}
+
+	fmt.Printf("%s❌ %s%s\n", color.Red, verdict.Summary(), color.NC)
+	os.Exit(verdict.ExitCode())
+}
+
 func limitConcurrency(requested int) int {
 	const maxAllowed = 25
 	if requested > maxAllowed {
@@ -205,7 +655,7 @@ func determineNamespaces(svc *service.ScannerService, cfg *config.Config, flags
 func getAllNamespacesWithFilters(svc *service.ScannerService, flags *CLIFlags) ([]string, error) {
 	printInfo("⏳ 모든 네임스페이스 조회 중...")
 
-	allNamespaces, err := svc.GetAllNamespaces()
+	allNamespaces, err := svc.GetAllNamespaces(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("네임스페이스 조회 실패: %w", err)
 	}
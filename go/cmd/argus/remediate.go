@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/history"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/remediate"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/client"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/utils/color"
+)
+
+// runRemediateCommand는 argus remediate를 처리한다.
+// JSON 리포트의 수동 리소스 중 선택한 리소스에 작업 하나를 적용하고 변경 로그를 남긴다.
+func runRemediateCommand(args []string) {
+	fs := flag.NewFlagSet("argus remediate", flag.ExitOnError)
+	reportPath := fs.String("report", "", "스캔 결과 JSON 리포트 파일 또는 디렉토리 (디렉토리면 가장 최근 리포트) (필수)")
+	actionName := fs.String("action", "", "적용할 작업: annotate, adopt, delete-plan (필수)")
+	selectors := fs.String("select", "", "대상 수동 리소스 패턴 (<네임스페이스>/<Kind>/<이름>, 쉼표로 구분, 비우면 전체)")
+	annotation := fs.String("annotation", remediate.DefaultAnnotation+"=true", "annotate 작업에서 추가할 어노테이션 (키=값)")
+	application := fs.String("app", "", "adopt 작업에서 편입할 ArgoCD Application 이름")
+	tracking := fs.String("tracking", remediate.TrackingAnnotation, "adopt 작업의 추적 방식: annotation(tracking-id) 또는 label(instance 라벨)")
+	applicationNamespace := fs.String("app-namespace", config.DefaultArgoCDNamespace, "adopt 작업에서 Application을 조회할 네임스페이스 (-app이 <네임스페이스>_<이름> 형식이면 무시)")
+	dryRunName := fs.String("dry-run", string(remediate.DryRunClient), "client(기본, 요청 없이 기록만), server(API 서버에서 검증만), none(실제로 적용, 명시해야 함)")
+	yes := fs.Bool("yes", false, "리소스별 확인 없이 진행")
+	outputDir := fs.String("output-dir", "reports", "변경 로그와 삭제 계획 저장 디렉토리")
+	timeout := fs.Int("timeout", 30, "API 요청 타임아웃 (초)")
+	fs.Parse(args)
+
+	if *reportPath == "" || *actionName == "" {
+		exitWithError("사용법: argus remediate -report <리포트> -action <annotate|adopt|delete-plan> [-select 패턴] [--dry-run=server|none]")
+	}
+	action, err := remediate.ParseAction(*actionName)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+	dryRun, err := remediate.ParseDryRun(*dryRunName)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	path, err := history.ResolveReportPath(*reportPath)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+	snapshot, err := history.Load(path)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	resources, err := remediate.SelectManualResources(snapshot.Report.Results, splitNonEmpty(*selectors))
+	if err != nil {
+		exitWithError("%v", err)
+	}
+	if len(resources) == 0 {
+		printWarning("선택된 수동 리소스가 없습니다")
+		return
+	}
+
+	k8sClient, err := client.NewClient(&client.ClientConfig{Timeout: time.Duration(*timeout) * time.Second})
+	if err != nil {
+		exitWithError("Kubernetes 클라이언트 초기화 실패: %v", err)
+	}
+	kubeContext, _ := k8sClient.GetCurrentContext()
+	if snapshot.Report.Metadata.Context != kubeContext {
+		exitWithError("리포트의 컨텍스트(%s)와 현재 컨텍스트(%s)가 다릅니다", snapshot.Report.Metadata.Context, kubeContext)
+	}
+
+	options := remediate.Options{
+		Action:         action,
+		DryRun:         dryRun,
+		Application:    *application,
+		TrackingMethod: *tracking,
+	}
+	options.AnnotationKey, options.AnnotationValue, _ = strings.Cut(*annotation, "=")
+	if !*yes {
+		options.Confirm = newRemediationPrompt(len(resources))
+	}
+
+	remediator, err := remediate.NewRemediator(k8sClient.Dynamic(), k8sClient.RESTMapper(), options)
+	if err != nil {
+		exitWithError("%v", err)
+	}
+
+	printInfo("🛠️  %s 작업 (--dry-run=%s): %s의 수동 리소스 %d개", action, dryRun, filepath.Base(path), len(resources))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if action == remediate.ActionAdopt {
+		warnAutoPrune(ctx, k8sClient, *applicationNamespace, *application)
+	}
+	switch {
+	case dryRun == remediate.DryRunNone && action != remediate.ActionDeletePlan:
+		printWarning("--dry-run=none: 클러스터의 리소스를 실제로 변경합니다")
+	case dryRun == remediate.DryRunClient:
+		printInfo("--dry-run=client: 클러스터에 요청하지 않고 변경 내용만 기록합니다 (적용하려면 --dry-run=none)")
+	}
+
+	changes, runErr := remediator.Run(ctx, resources)
+	now := time.Now()
+	writeRemediationOutputs(changes, action, *outputDir, now)
+	printRemediationSummary(changes)
+	if runErr != nil {
+		exitWithError("작업 중단: %v", runErr)
+	}
+}
+
+// warnAutoPrune은 adopt 대상 Application에 자동 prune이 켜져 있으면 Git에 없는 리소스가 삭제될 수 있음을 경고한다
+func warnAutoPrune(ctx context.Context, k8sClient *client.Client, namespace, application string) {
+	prune, err := remediate.ApplicationAutoPrune(ctx, k8sClient.Dynamic(), namespace, application)
+	switch {
+	case err != nil:
+		printWarning("Application %s의 동기화 정책을 확인하지 못했습니다: %v", application, err)
+		printWarning("자동 prune이 켜진 Application에 편입하면 Git에 매니페스트가 없는 리소스를 ArgoCD가 삭제합니다")
+	case prune:
+		printWarning("Application %s는 자동 prune(syncPolicy.automated.prune)이 켜져 있습니다. Git에 매니페스트가 없는 리소스는 편입 후 다음 동기화에서 ArgoCD가 삭제합니다", application)
+	}
+}
+
+// newRemediationPrompt는 리소스마다 변경 내용을 보여주고 y(적용)/N(건너뜀)/a(남은 리소스 모두 적용)/q(중단)를 묻는다
+func newRemediationPrompt(total int) func(domain.KubernetesResource, remediate.Change) (bool, error) {
+	index := 0
+	approveAll := false
+	return func(resource domain.KubernetesResource, change remediate.Change) (bool, error) {
+		index++
+		if approveAll {
+			return true, nil
+		}
+
+		fmt.Printf("\n%s[%d/%d] %s%s (%s)\n", color.Bold, index, total, change.Target(), color.NC, change.APIVersion)
+		if change.Patch != "" {
+			fmt.Printf("  patch: %s\n", change.Patch)
+		}
+		if change.Command != "" {
+			fmt.Printf("  명령: %s\n", change.Command)
+		}
+		fmt.Printf("%s진행하시겠습니까? (y/N/a=모두/q=중단): %s", color.Yellow, color.NC)
+
+		var response string
+		fmt.Scanln(&response)
+		switch strings.ToLower(strings.TrimSpace(response)) {
+		case "y":
+			return true, nil
+		case "a":
+			approveAll = true
+			return true, nil
+		case "q":
+			return false, remediate.ErrAborted
+		}
+		return false, nil
+	}
+}
+
+func writeRemediationOutputs(changes []remediate.Change, action remediate.Action, outputDir string, now time.Time) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		printWarning("출력 디렉토리 생성 실패: %v", err)
+		return
+	}
+	timestamp := now.Format("20060102_150405")
+
+	logFile := filepath.Join(outputDir, fmt.Sprintf("argus-remediate_%s.jsonl", timestamp))
+	if err := writeFileWith(logFile, func(f *os.File) error { return remediate.WriteChangeLog(f, changes) }); err != nil {
+		printWarning("변경 로그 저장 실패: %v", err)
+	} else {
+		fmt.Printf("📝 변경 로그: %s\n", logFile)
+	}
+
+	if action != remediate.ActionDeletePlan {
+		return
+	}
+	planFile := filepath.Join(outputDir, fmt.Sprintf("argus-delete-plan_%s.sh", timestamp))
+	if err := writeFileWith(planFile, func(f *os.File) error { return remediate.WriteDeletePlan(f, changes, now) }); err != nil {
+		printWarning("삭제 계획 저장 실패: %v", err)
+	} else {
+		fmt.Printf("🗑️  삭제 계획: %s (검토 후 직접 실행하세요)\n", planFile)
+	}
+}
+
+func writeFileWith(filename string, write func(*os.File) error) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func printRemediationSummary(changes []remediate.Change) {
+	counts := make(map[string]int)
+	for _, change := range changes {
+		counts[change.Status]++
+		switch {
+		case change.Status == remediate.StatusFailed:
+			fmt.Printf("%s❌ %s: %s%s\n", color.Red, change.Target(), change.Error, color.NC)
+		case change.Status == remediate.StatusSkipped && change.Error != "":
+			fmt.Printf("%s⏭️  %s: %s%s\n", color.Yellow, change.Target(), change.Error, color.NC)
+		}
+	}
+	fmt.Printf("\n적용 %d, dry-run %d, 계획 %d, 건너뜀 %d, 실패 %d\n",
+		counts[remediate.StatusApplied], counts[remediate.StatusDryRun], counts[remediate.StatusPlanned],
+		counts[remediate.StatusSkipped], counts[remediate.StatusFailed])
+}
+
+func splitNonEmpty(value string) []string {
+	var parts []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/client"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/utils/color"
+)
+
+// runConfigCommand는 argus config <하위 명령>을 처리한다
+func runConfigCommand(args []string) {
+	if len(args) == 0 || args[0] != "validate" {
+		exitWithError("사용법: argus config validate [-f rules.yaml] [-offline] [-strict]")
+	}
+	validateConfigFile(args[1:])
+}
+
+// validateConfigFile은 설정 파일을 엄격하게 검사하고, 오류가 있으면(-strict이면 경고도) 종료 코드 1로 끝낸다
+func validateConfigFile(args []string) {
+	fs := flag.NewFlagSet("argus config validate", flag.ExitOnError)
+	configFile := fs.String("f", "rules.yaml", "검사할 설정 파일 경로")
+	offline := fs.Bool("offline", false, "클러스터에 접속하지 않음 (resource_types 검사 생략)")
+	strict := fs.Bool("strict", false, "경고도 실패로 처리")
+	timeout := fs.Int("timeout", 30, "클러스터 조회 타임아웃 (초)")
+	fs.Parse(args)
+
+	data, err := os.ReadFile(*configFile)
+	if err != nil {
+		exitWithError("설정 파일 읽기 실패: %v", err)
+	}
+
+	issues := config.Validate(data)
+	if !*offline {
+		served, err := fetchServedResourceTypes(time.Duration(*timeout) * time.Second)
+		if err != nil {
+			printWarning("클러스터 리소스 타입 조회 실패, resource_types 검사를 건너뜁니다: %v", err)
+		} else {
+			issues = append(issues, config.ValidateResourceTypes(data, served)...)
+		}
+	}
+
+	errorCount, warningCount := 0, 0
+	for _, issue := range issues {
+		if issue.IsError() {
+			errorCount++
+		} else {
+			warningCount++
+		}
+		printIssue(*configFile, issue)
+	}
+
+	if errorCount == 0 && warningCount == 0 {
+		printSuccess("%s: 문제 없음", *configFile)
+		return
+	}
+	fmt.Printf("\n오류 %d개, 경고 %d개\n", errorCount, warningCount)
+	if errorCount > 0 || (*strict && warningCount > 0) {
+		os.Exit(1)
+	}
+}
+
+func fetchServedResourceTypes(timeout time.Duration) ([]string, error) {
+	k8sClient, err := client.NewClient(&client.ClientConfig{Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return k8sClient.GetServedResourceTypes(ctx)
+}
+
+func printIssue(file string, issue config.Issue) {
+	location := file
+	if issue.Line > 0 {
+		location = fmt.Sprintf("%s:%d", file, issue.Line)
+	}
+	if issue.IsError() {
+		fmt.Printf("%s: %s❌ %s%s\n", location, color.Red, issue.Message, color.NC)
+		return
+	}
+	fmt.Printf("%s: %s⚠️ %s%s\n", location, color.Yellow, issue.Message, color.NC)
+}
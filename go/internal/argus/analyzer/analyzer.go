@@ -1,18 +1,38 @@
 package analyzer
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 )
 
 type Analyzer struct {
 	config *config.Config
+	// argoCDApplications가 nil이면 Application 존재 여부를 확인하지 않는다
+	argoCDApplications map[string]bool
+	// skipQualifiedApplications이면 다른 네임스페이스의 Application("<네임스페이스>_<이름>")을 가리키는 리소스는 확인하지 않는다
+	skipQualifiedApplications bool
 }
 
 func NewAnalyzer(cfg *config.Config) *Analyzer {
 	return &Analyzer{config: cfg}
 }
 
+// SetArgoCDApplications는 클러스터에 존재하는 Application 이름 목록을 설정한다.
+// 이후 분석에서 없는 Application을 가리키는 리소스는 고아 GitOps 리소스로 분류된다.
+func (a *Analyzer) SetArgoCDApplications(applications map[string]bool) {
+	a.argoCDApplications = applications
+}
+
+// SkipQualifiedApplications는 ArgoCD 네임스페이스의 Application만 조회한 경우에 호출해,
+// 목록에 없는 다른 네임스페이스의 Application을 가리키는 리소스를 고아로 분류하지 않게 한다.
+func (a *Analyzer) SkipQualifiedApplications() {
+	a.skipQualifiedApplications = true
+}
+
 func (a *Analyzer) AnalyzeResources(resources []domain.KubernetesResource) domain.AnalysisResult {
 	result := domain.AnalysisResult{
 		TotalResources:     len(resources),
@@ -21,129 +41,224 @@ func (a *Analyzer) AnalyzeResources(resources []domain.KubernetesResource) domai
 	}
 
 	for _, resource := range resources {
-		if !resource.IsRootResource() {
+		classification := a.Classify(&resource)
+		if classification == domain.ClassificationChild {
 			continue
 		}
 
 		result.RootResources++
+		// 원본 객체는 수동 리소스의 매니페스트 내보내기에만 필요하므로 나머지는 메모리에 남기지 않는다
+		if classification != domain.ClassificationManual {
+			resource.Object = nil
+		}
 
-		if a.shouldExcludeResource(&resource) {
+		switch classification {
+		case domain.ClassificationExcluded:
 			result.ExcludedDefaults++
-			continue
+			result.ExcludedResourceList = append(result.ExcludedResourceList, resource)
+		case domain.ClassificationOrphaned:
+			result.OrphanedGitOps++
+			result.OrphanedResourceList = append(result.OrphanedResourceList, resource)
+		case domain.ClassificationArgoCD:
+			result.ArgoCDManaged++
+			result.ArgoCDResourceList = append(result.ArgoCDResourceList, resource)
+			countManager(&result, domain.ManagerArgoCD)
+		case domain.ClassificationOtherManaged:
+			result.OtherManaged++
+			result.OtherManagedResourceList = append(result.OtherManagedResourceList, resource)
+			countManager(&result, resource.Manager)
+		default:
+			result.ManualResources++
+			result.ManualResourceList = append(result.ManualResourceList, resource)
 		}
-
-		a.categorizeResource(&resource, &result)
 	}
 
 	return result
 }
 
-func (a *Analyzer) categorizeResource(resource *domain.KubernetesResource, result *domain.AnalysisResult) {
-	if resource.IsArgoCDManaged() {
-		result.ArgoCDManaged++
-		result.ArgoCDResourceList = append(result.ArgoCDResourceList, *resource)
-	} else {
-		result.ManualResources++
-		result.ManualResourceList = append(result.ManualResourceList, *resource)
+// Classify는 리소스 하나를 분류하고, 분류를 결정한 규칙을 resource.DecidedBy에 기록한다
+func (a *Analyzer) Classify(resource *domain.KubernetesResource) domain.Classification {
+	if !resource.IsRootResource() {
+		return domain.ClassificationChild
 	}
+
+	if rule := a.exclusionRule(resource); rule != nil {
+		resource.DecidedBy = rule
+		return domain.ClassificationExcluded
+	}
+
+	if rule := a.orphanedGitOpsRule(resource); rule != nil {
+		resource.DecidedBy = rule
+		return domain.ClassificationOrphaned
+	}
+
+	if rule := resource.ArgoCDTrackingRule(); rule != nil {
+		resource.Manager = domain.ManagerArgoCD
+		resource.DecidedBy = rule
+		return domain.ClassificationArgoCD
+	}
+
+	// 모든 Helm 차트가 app.kubernetes.io/instance를 붙이므로 공용 라벨보다 managers 규칙을 먼저 확인한다
+	if manager, field, pattern := a.config.DetectManagerRule(resource.Labels, resource.Annotations, resource.FieldManagers()); manager != nil {
+		resource.Manager = manager.Name
+		resource.DecidedBy = &domain.DecisionRule{Section: fmt.Sprintf("managers[%s].%s", manager.Name, field), Pattern: pattern}
+		return domain.ClassificationOtherManaged
+	}
+
+	if rule := resource.ArgoCDSharedLabelRule(); rule != nil {
+		resource.Manager = domain.ManagerArgoCD
+		resource.DecidedBy = rule
+		return domain.ClassificationArgoCD
+	}
+
+	return domain.ClassificationManual
 }
 
-func (a *Analyzer) shouldExcludeResource(resource *domain.KubernetesResource) bool {
-	if a.matchesExclusionRule(resource) {
-		return true
+func countManager(result *domain.AnalysisResult, manager string) {
+	if result.ManagerCounts == nil {
+		result.ManagerCounts = make(map[string]int)
 	}
+	result.ManagerCounts[manager]++
+}
 
-	if a.isExcludedSecret(resource) {
-		return true
+func (a *Analyzer) orphanedGitOpsRule(resource *domain.KubernetesResource) *domain.DecisionRule {
+	if a.argoCDApplications == nil {
+		return nil
 	}
 
-	if a.isRancherManaged(resource) {
-		return true
+	application := resource.ArgoCDApplication()
+	if application == "" || a.argoCDApplications[application] {
+		return nil
+	}
+	// Application 이름에는 '_'를 쓸 수 없으므로 '_'가 있으면 다른 네임스페이스의 Application이다
+	if a.skipQualifiedApplications && strings.Contains(application, "_") {
+		return nil
+	}
+	return &domain.DecisionRule{Section: "argocd.verify_applications", Pattern: fmt.Sprintf("Application %s 없음", application)}
+}
+
+// exclusionRule은 리소스를 기본 리소스로 제외한 규칙을 반환하며, 제외 대상이 아니면 nil이다
+func (a *Analyzer) exclusionRule(resource *domain.KubernetesResource) *domain.DecisionRule {
+	if rule := a.matchExclusionRule(resource); rule != nil {
+		return rule
+	}
+
+	if rule := a.selectorRule(resource); rule != nil {
+		return rule
+	}
+
+	if rule := a.excludedSecretRule(resource); rule != nil {
+		return rule
 	}
 
-	if a.hasAutoManagedAnnotation(resource) {
-		return true
+	if rule := a.rancherManagedRule(resource); rule != nil {
+		return rule
 	}
 
-	if a.isStatefulSetPVC(resource) {
-		return true
+	if rule := a.autoManagedAnnotationRule(resource); rule != nil {
+		return rule
 	}
 
-	return false
+	return a.statefulSetPVCRule(resource)
+}
+
+func (a *Analyzer) shouldExcludeResource(resource *domain.KubernetesResource) bool {
+	return a.exclusionRule(resource) != nil
 }
 
-func (a *Analyzer) matchesExclusionRule(resource *domain.KubernetesResource) bool {
+func (a *Analyzer) matchExclusionRule(resource *domain.KubernetesResource) *domain.DecisionRule {
 	for _, rule := range a.config.ExclusionRules {
 		if rule.Match(resource.Identifier.Namespace, resource.Identifier.Kind, resource.Identifier.Name) {
-			return true
+			return &domain.DecisionRule{Section: "exclusions." + rule.Category, Pattern: rule.Pattern}
 		}
 	}
-	return false
+	return nil
 }
 
-func (a *Analyzer) isExcludedSecret(resource *domain.KubernetesResource) bool {
+func (a *Analyzer) selectorRule(resource *domain.KubernetesResource) *domain.DecisionRule {
+	if section, expression := a.config.ResourceSelectors.Excluded(resource.Labels, resource.Annotations); section != "" {
+		return &domain.DecisionRule{Section: section, Pattern: expression}
+	}
+	return nil
+}
+
+func (a *Analyzer) excludedSecretRule(resource *domain.KubernetesResource) *domain.DecisionRule {
 	if resource.Identifier.Kind != "Secret" {
-		return false
+		return nil
 	}
 
-	if a.matchesSecretPattern(resource.Identifier.Name) {
-		return true
+	if rule := a.secretPatternRule(resource.Identifier.Name); rule != nil {
+		return rule
 	}
 
-	return a.hasCertManagerAnnotation(resource)
+	return a.certManagerAnnotationRule(resource)
 }
 
-func (a *Analyzer) matchesSecretPattern(name string) bool {
+func (a *Analyzer) secretPatternRule(name string) *domain.DecisionRule {
 	for _, pattern := range a.config.SecretPatterns {
 		if pattern.MatchString(name) {
-			return true
+			return &domain.DecisionRule{Section: "patterns.secret_patterns", Pattern: pattern.String()}
 		}
 	}
-	return false
+	return nil
 }
 
-func (a *Analyzer) hasCertManagerAnnotation(resource *domain.KubernetesResource) bool {
-	for annotation := range a.config.CertManagerAnnotations {
-		if _, ok := resource.Annotations[annotation]; ok {
-			return true
-		}
+func (a *Analyzer) certManagerAnnotationRule(resource *domain.KubernetesResource) *domain.DecisionRule {
+	if annotation := firstPresentKey(a.config.CertManagerAnnotations, resource.Annotations); annotation != "" {
+		return &domain.DecisionRule{Section: "auto_managed.cert_manager_annotations", Pattern: annotation}
 	}
-	return false
+	return nil
 }
 
-func (a *Analyzer) isRancherManaged(resource *domain.KubernetesResource) bool {
+func (a *Analyzer) rancherManagedRule(resource *domain.KubernetesResource) *domain.DecisionRule {
 	patterns, ok := a.config.RancherManagedPatterns[resource.Identifier.Kind]
 	if !ok {
-		return false
+		return nil
 	}
 
 	for _, pattern := range patterns {
 		if pattern.MatchString(resource.Identifier.Name) {
-			return true
+			return &domain.DecisionRule{Section: "patterns.rancher_managed." + resource.Identifier.Kind, Pattern: pattern.String()}
 		}
 	}
-	return false
+	return nil
 }
 
-func (a *Analyzer) hasAutoManagedAnnotation(resource *domain.KubernetesResource) bool {
-	for annotation := range a.config.AutoManagedAnnotations {
-		if _, ok := resource.Annotations[annotation]; ok {
-			return true
+func (a *Analyzer) autoManagedAnnotationRule(resource *domain.KubernetesResource) *domain.DecisionRule {
+	if annotation := firstPresentKey(a.config.AutoManagedAnnotations, resource.Annotations); annotation != "" {
+		return &domain.DecisionRule{Section: "auto_managed.annotations", Pattern: annotation}
+	}
+	return nil
+}
+
+// firstPresentKey는 keys 중 values에 있는 키를 정렬 순서로 처음 하나 반환한다
+func firstPresentKey(keys map[string]bool, values map[string]string) string {
+	var present []string
+	for key := range keys {
+		if _, ok := values[key]; ok {
+			present = append(present, key)
 		}
 	}
-	return false
+	if len(present) == 0 {
+		return ""
+	}
+	sort.Strings(present)
+	return present[0]
 }
 
-func (a *Analyzer) isStatefulSetPVC(resource *domain.KubernetesResource) bool {
+func (a *Analyzer) statefulSetPVCRule(resource *domain.KubernetesResource) *domain.DecisionRule {
 	if resource.Identifier.Kind != "PersistentVolumeClaim" {
-		return false
+		return nil
 	}
 
 	if a.config.StatefulSetPVCPattern.MatchString(resource.Identifier.Name) {
-		return true
+		return &domain.DecisionRule{Section: "patterns.statefulset_pvc", Pattern: a.config.StatefulSetPVCPattern.String()}
 	}
 
-	return a.hasStatefulSetLabels(resource)
+	if a.hasStatefulSetLabels(resource) {
+		return &domain.DecisionRule{Section: "patterns.statefulset_pvc", Pattern: "app.kubernetes.io/instance + app.kubernetes.io/component 라벨"}
+	}
+	return nil
 }
 
 func (a *Analyzer) hasStatefulSetLabels(resource *domain.KubernetesResource) bool {
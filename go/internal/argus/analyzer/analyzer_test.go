@@ -144,6 +144,180 @@ func TestAnalyzeResources(t *testing.T) {
 	}
 }
 
+func TestAnalyzeResources_OrphanedGitOps(t *testing.T) {
+	cfg := &config.Config{ArgoCD: config.ArgoCDConfig{SyncAnnotations: []string{"argocd.argoproj.io/sync-wave"}}}
+	resources := []domain.KubernetesResource{
+		{
+			Identifier: domain.ResourceIdentifier{Kind: "Deployment", Name: "live"},
+			Labels:     map[string]string{"argocd.argoproj.io/instance": "existing-app"},
+		},
+		{
+			Identifier:  domain.ResourceIdentifier{Kind: "ConfigMap", Name: "orphan"},
+			Annotations: map[string]string{"argocd.argoproj.io/tracking-id": "deleted-app:/ConfigMap:default/orphan"},
+		},
+		{
+			Identifier:  domain.ResourceIdentifier{Kind: "Service", Name: "synced"},
+			Annotations: map[string]string{"argocd.argoproj.io/sync-wave": "1"},
+			Config:      cfg,
+		},
+		{
+			Identifier: domain.ResourceIdentifier{Kind: "Secret", Name: "manual"},
+		},
+	}
+
+	analyzer := NewAnalyzer(cfg)
+	result := analyzer.AnalyzeResources(resources)
+	if result.OrphanedGitOps != 0 || result.ArgoCDManaged != 3 {
+		t.Errorf("Application 목록이 없으면 고아 검사를 하지 않아야 합니다: %+v", result)
+	}
+
+	analyzer.SetArgoCDApplications(map[string]bool{"existing-app": true})
+	result = analyzer.AnalyzeResources(resources)
+
+	if result.OrphanedGitOps != 1 || len(result.OrphanedResourceList) != 1 {
+		t.Fatalf("OrphanedGitOps = %v, want 1", result.OrphanedGitOps)
+	}
+	if result.OrphanedResourceList[0].Identifier.Name != "orphan" {
+		t.Errorf("OrphanedResourceList[0] = %v, want orphan", result.OrphanedResourceList[0].Identifier.Name)
+	}
+	if result.ArgoCDManaged != 2 {
+		t.Errorf("ArgoCDManaged = %v, want 2 (Application을 특정할 수 없는 리소스는 관리로 유지)", result.ArgoCDManaged)
+	}
+	if result.ManualResources != 1 {
+		t.Errorf("ManualResources = %v, want 1", result.ManualResources)
+	}
+}
+
+func TestAnalyzeResources_OtherManagers(t *testing.T) {
+	cfg := &config.Config{Managers: []config.ManagerRule{
+		{Name: "Helm", Annotations: map[string]string{"meta.helm.sh/release-name": "*"}},
+		{Name: "Terraform", FieldManagers: []string{"terraform-provider-*"}},
+	}}
+	resources := []domain.KubernetesResource{
+		{
+			Identifier: domain.ResourceIdentifier{Kind: "Deployment", Name: "argocd"},
+			Labels:     map[string]string{"argocd.argoproj.io/instance": "app"},
+			// ArgoCD가 Helm 차트를 배포해도 ArgoCD 관리로 분류되어야 한다
+			Annotations: map[string]string{"meta.helm.sh/release-name": "app"},
+		},
+		{
+			Identifier:  domain.ResourceIdentifier{Kind: "Deployment", Name: "helm"},
+			Annotations: map[string]string{"meta.helm.sh/release-name": "release"},
+		},
+		{
+			Identifier:    domain.ResourceIdentifier{Kind: "ConfigMap", Name: "terraform"},
+			ManagedFields: []domain.ManagedFieldEntry{{Manager: "terraform-provider-kubernetes", Operation: "Update"}},
+		},
+		{
+			Identifier: domain.ResourceIdentifier{Kind: "Secret", Name: "manual"},
+		},
+	}
+
+	result := NewAnalyzer(cfg).AnalyzeResources(resources)
+
+	if result.ArgoCDManaged != 1 || result.OtherManaged != 2 || result.ManualResources != 1 {
+		t.Errorf("ArgoCD/기타/수동 = %v/%v/%v, want 1/2/1", result.ArgoCDManaged, result.OtherManaged, result.ManualResources)
+	}
+	if result.ManagerCounts["Helm"] != 1 || result.ManagerCounts["Terraform"] != 1 || result.ManagerCounts[domain.ManagerArgoCD] != 1 {
+		t.Errorf("ManagerCounts = %v", result.ManagerCounts)
+	}
+	if len(result.OtherManagedResourceList) != 2 || result.OtherManagedResourceList[0].Manager != "Helm" {
+		t.Errorf("OtherManagedResourceList = %+v", result.OtherManagedResourceList)
+	}
+	if result.ManualResourceList[0].Manager != "" {
+		t.Errorf("수동 리소스의 Manager = %v, want 빈 값", result.ManualResourceList[0].Manager)
+	}
+}
+
+func TestClassify_DefaultRules(t *testing.T) {
+	cfg, err := config.LoadConfigFromFile("../../../cmd/argus/rules.yaml")
+	if err != nil {
+		t.Fatalf("기본 rules.yaml 로드 실패: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		labels      map[string]interface{}
+		annotations map[string]interface{}
+		want        domain.Classification
+		wantManager string
+	}{
+		{
+			name:        "helm install로 설치한 차트",
+			labels:      map[string]interface{}{"app.kubernetes.io/instance": "redis", "app.kubernetes.io/managed-by": "Helm"},
+			annotations: map[string]interface{}{"meta.helm.sh/release-name": "redis"},
+			want:        domain.ClassificationOtherManaged,
+			wantManager: "Helm",
+		},
+		{
+			name:        "Flux HelmRelease로 설치한 차트",
+			labels:      map[string]interface{}{"app.kubernetes.io/instance": "redis", "app.kubernetes.io/managed-by": "Helm", "helm.toolkit.fluxcd.io/name": "redis"},
+			want:        domain.ClassificationOtherManaged,
+			wantManager: "Flux",
+		},
+		{
+			name:        "ArgoCD가 annotation 추적으로 배포한 Helm 차트",
+			labels:      map[string]interface{}{"app.kubernetes.io/instance": "redis", "app.kubernetes.io/managed-by": "Helm"},
+			annotations: map[string]interface{}{"argocd.argoproj.io/tracking-id": "redis:apps/Deployment:app/redis"},
+			want:        domain.ClassificationArgoCD,
+			wantManager: domain.ManagerArgoCD,
+		},
+		{
+			name:        "ArgoCD 라벨 추적 (app.kubernetes.io/instance)",
+			labels:      map[string]interface{}{"app.kubernetes.io/instance": "web"},
+			want:        domain.ClassificationArgoCD,
+			wantManager: domain.ManagerArgoCD,
+		},
+		{
+			name: "추적 정보 없음",
+			want: domain.ClassificationManual,
+		},
+	}
+
+	analyzer := NewAnalyzer(cfg)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := MapToResource(map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":        "redis",
+					"namespace":   "app",
+					"labels":      tt.labels,
+					"annotations": tt.annotations,
+				},
+			}, "app", cfg)
+
+			if got := analyzer.Classify(resource); got != tt.want {
+				t.Errorf("Classify() = %v, want %v (%v)", got, tt.want, resource.DecidedBy)
+			}
+			if resource.Manager != tt.wantManager {
+				t.Errorf("Manager = %v, want %v", resource.Manager, tt.wantManager)
+			}
+		})
+	}
+}
+
+func TestAnalyzeResources_ExcludedResourceList(t *testing.T) {
+	cfg := &config.Config{
+		ExclusionRules: []config.ExclusionRule{{Namespace: "*", Kind: "Job", Name: "*", Pattern: "*/Job/*", Category: "others"}},
+	}
+	resources := []domain.KubernetesResource{
+		{Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "Job", Name: "migrate"}},
+		{Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "ConfigMap", Name: "hand-made"}},
+	}
+
+	result := NewAnalyzer(cfg).AnalyzeResources(resources)
+
+	if result.ExcludedDefaults != 1 || len(result.ExcludedResourceList) != 1 {
+		t.Fatalf("ExcludedDefaults = %v, ExcludedResourceList = %v, want 1개", result.ExcludedDefaults, result.ExcludedResourceList)
+	}
+	excluded := result.ExcludedResourceList[0]
+	if excluded.Identifier.Name != "migrate" || excluded.DecidedBy == nil || excluded.DecidedBy.Section != "exclusions.others" {
+		t.Errorf("ExcludedResourceList[0] = %+v", excluded)
+	}
+}
+
 func TestShouldExcludeResource(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -276,6 +450,39 @@ func TestShouldExcludeResource(t *testing.T) {
 			config: &config.Config{},
 			want:   false,
 		},
+		{
+			name: "라벨 제외 선택자와 일치하는 리소스",
+			resource: &domain.KubernetesResource{
+				Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "ConfigMap", Name: "shared"},
+				Labels:     map[string]string{"app.kubernetes.io/part-of": "platform"},
+			},
+			config: &config.Config{
+				ResourceSelectors: mustSelectorSet(t, config.SelectorRules{ExcludeLabels: []string{"app.kubernetes.io/part-of=platform"}}),
+			},
+			want: true,
+		},
+		{
+			name: "어노테이션 제외 선택자와 일치하는 리소스",
+			resource: &domain.KubernetesResource{
+				Identifier:  domain.ResourceIdentifier{Namespace: "app", Kind: "Deployment", Name: "debug"},
+				Annotations: map[string]string{"ops.bellsoft/ignore-argus": "true"},
+			},
+			config: &config.Config{
+				ResourceSelectors: mustSelectorSet(t, config.SelectorRules{ExcludeAnnotations: []string{"ops.bellsoft/ignore-argus=true"}}),
+			},
+			want: true,
+		},
+		{
+			name: "include 선택자와 일치하지 않는 리소스",
+			resource: &domain.KubernetesResource{
+				Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "Service", Name: "web"},
+				Labels:     map[string]string{"team": "search"},
+			},
+			config: &config.Config{
+				ResourceSelectors: mustSelectorSet(t, config.SelectorRules{IncludeLabels: []string{"team=payments"}}),
+			},
+			want: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -288,7 +495,99 @@ func TestShouldExcludeResource(t *testing.T) {
 	}
 }
 
-func TestIsStatefulSetPVC(t *testing.T) {
+func TestClassify_DecidedBy(t *testing.T) {
+	cfg := &config.Config{
+		ExclusionRules: []config.ExclusionRule{
+			{Namespace: "cert-manager", Kind: "*", Name: "*", Pattern: "cert-manager/*/*", Category: "others"},
+		},
+		AutoManagedAnnotations: map[string]bool{"kubernetes.io/service-account.name": true},
+		StatefulSetPVCPattern:  regexp.MustCompile(`-\d+$`),
+		ArgoCD:                 config.ArgoCDConfig{FieldManagers: []string{"argocd-*"}},
+		Managers: []config.ManagerRule{
+			{Name: "Helm", Labels: map[string]string{"app.kubernetes.io/managed-by": "Helm"}},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		resource           domain.KubernetesResource
+		wantClassification domain.Classification
+		wantRule           string
+	}{
+		{
+			name:               "제외 규칙",
+			resource:           domain.KubernetesResource{Identifier: domain.ResourceIdentifier{Namespace: "cert-manager", Kind: "Secret", Name: "ca"}},
+			wantClassification: domain.ClassificationExcluded,
+			wantRule:           "exclusions.others: cert-manager/*/*",
+		},
+		{
+			name: "자동 관리 어노테이션",
+			resource: domain.KubernetesResource{
+				Identifier:  domain.ResourceIdentifier{Namespace: "app", Kind: "Secret", Name: "token"},
+				Annotations: map[string]string{"kubernetes.io/service-account.name": "default"},
+			},
+			wantClassification: domain.ClassificationExcluded,
+			wantRule:           "auto_managed.annotations: kubernetes.io/service-account.name",
+		},
+		{
+			name:               "StatefulSet PVC",
+			resource:           domain.KubernetesResource{Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "PersistentVolumeClaim", Name: "data-db-0"}},
+			wantClassification: domain.ClassificationExcluded,
+			wantRule:           `patterns.statefulset_pvc: -\d+$`,
+		},
+		{
+			name: "ArgoCD 필드 매니저",
+			resource: domain.KubernetesResource{
+				Identifier:    domain.ResourceIdentifier{Namespace: "app", Kind: "ConfigMap", Name: "synced"},
+				ManagedFields: []domain.ManagedFieldEntry{{Manager: "argocd-controller", Operation: "Apply"}},
+			},
+			wantClassification: domain.ClassificationArgoCD,
+			wantRule:           "argocd.field_managers: argocd-*",
+		},
+		{
+			name: "다른 관리 도구",
+			resource: domain.KubernetesResource{
+				Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "ConfigMap", Name: "chart"},
+				Labels:     map[string]string{"app.kubernetes.io/managed-by": "Helm"},
+			},
+			wantClassification: domain.ClassificationOtherManaged,
+			wantRule:           "managers[Helm].labels: app.kubernetes.io/managed-by=Helm",
+		},
+		{
+			name:               "수동 리소스",
+			resource:           domain.KubernetesResource{Identifier: domain.ResourceIdentifier{Namespace: "app", Kind: "ConfigMap", Name: "hand-made"}},
+			wantClassification: domain.ClassificationManual,
+		},
+		{
+			name: "하위 리소스",
+			resource: domain.KubernetesResource{
+				Identifier:      domain.ResourceIdentifier{Namespace: "app", Kind: "Pod", Name: "web-abc"},
+				OwnerReferences: []interface{}{map[string]interface{}{"kind": "ReplicaSet"}},
+			},
+			wantClassification: domain.ClassificationChild,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := tt.resource
+			resource.Config = cfg
+
+			if got := NewAnalyzer(cfg).Classify(&resource); got != tt.wantClassification {
+				t.Errorf("Classify() = %v, want %v", got, tt.wantClassification)
+			}
+			var rule string
+			if resource.DecidedBy != nil {
+				rule = resource.DecidedBy.String()
+			}
+			if rule != tt.wantRule {
+				t.Errorf("DecidedBy = %q, want %q", rule, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestStatefulSetPVCRule(t *testing.T) {
 	tests := []struct {
 		name     string
 		resource *domain.KubernetesResource
@@ -356,9 +655,18 @@ func TestIsStatefulSetPVC(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			analyzer := &Analyzer{config: tt.config}
-			if got := analyzer.isStatefulSetPVC(tt.resource); got != tt.want {
-				t.Errorf("isStatefulSetPVC() = %v, want %v", got, tt.want)
+			if got := analyzer.statefulSetPVCRule(tt.resource); (got != nil) != tt.want {
+				t.Errorf("statefulSetPVCRule() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func mustSelectorSet(t *testing.T, rules config.SelectorRules) config.SelectorSet {
+	t.Helper()
+	set, err := config.NewSelectorSet("resources", rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
@@ -22,12 +22,32 @@ func MapToResource(obj map[string]interface{}, namespace string, cfg *config.Con
 		Labels:          getStringMap(metadata, "labels"),
 		Annotations:     getStringMap(metadata, "annotations"),
 		OwnerReferences: getSlice(metadata, "ownerReferences"),
+		ManagedFields:   getManagedFields(metadata),
 		Config:          cfg,
+		Object:          obj,
 	}
 
 	return resource
 }
 
+// managedFields의 fieldsV1은 크기가 크고 분석에 필요 없으므로 매니저 정보만 남긴다
+func getManagedFields(metadata map[string]interface{}) []domain.ManagedFieldEntry {
+	var entries []domain.ManagedFieldEntry
+	for _, item := range getSlice(metadata, "managedFields") {
+		field, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		entries = append(entries, domain.ManagedFieldEntry{
+			Manager:     getString(field, "manager"),
+			Operation:   getString(field, "operation"),
+			Time:        getString(field, "time"),
+			Subresource: getString(field, "subresource"),
+		})
+	}
+	return entries
+}
+
 // 유틸리티 함수들
 func getString(m map[string]interface{}, key string) string {
 	if v, ok := m[key].(string); ok {
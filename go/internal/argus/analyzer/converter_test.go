@@ -126,6 +126,42 @@ func TestMapToResource(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "managedFields 변환",
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name": "settings",
+					"managedFields": []interface{}{
+						map[string]interface{}{
+							"manager":   "kubectl-create",
+							"operation": "Update",
+							"time":      "2024-01-01T00:00:00Z",
+							"fieldsV1":  map[string]interface{}{"f:data": map[string]interface{}{}},
+						},
+						map[string]interface{}{
+							"manager":     "kube-controller-manager",
+							"operation":   "Update",
+							"time":        "2024-01-02T00:00:00Z",
+							"subresource": "status",
+						},
+						"invalid",
+					},
+				},
+			},
+			namespace:   "default",
+			expectedNil: false,
+			expectedCheck: func(t *testing.T, r *domain.KubernetesResource) {
+				want := []domain.ManagedFieldEntry{
+					{Manager: "kubectl-create", Operation: "Update", Time: "2024-01-01T00:00:00Z"},
+					{Manager: "kube-controller-manager", Operation: "Update", Time: "2024-01-02T00:00:00Z", Subresource: "status"},
+				}
+				if !reflect.DeepEqual(r.ManagedFields, want) {
+					t.Errorf("ManagedFields = %+v, want %+v", r.ManagedFields, want)
+				}
+			},
+		},
 		{
 			name: "ownerReferences가 없는 경우",
 			obj: map[string]interface{}{
@@ -0,0 +1,259 @@
+package baseline
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// DateLayout은 베이스라인 파일에서 사용하는 날짜 형식이다
+const DateLayout = "2006-01-02"
+
+const DefaultFile = "argus-baseline.yaml"
+
+// Entry는 수용된(알려진) 수동 리소스 하나를 나타낸다.
+// 클러스터 범위 리소스는 제외 규칙과 마찬가지로 Namespace에 domain.ClusterScope를 쓴다.
+type Entry struct {
+	Namespace  string `yaml:"namespace"`
+	Kind       string `yaml:"kind"`
+	Name       string `yaml:"name"`
+	APIVersion string `yaml:"apiVersion,omitempty"`
+	Reason     string `yaml:"reason"`
+	Owner      string `yaml:"owner"`
+	Expires    string `yaml:"expires,omitempty"`
+	AddedAt    string `yaml:"addedAt"`
+}
+
+type Baseline struct {
+	Entries []Entry `yaml:"entries"`
+}
+
+// Acceptance는 --write-baseline으로 새로 기록하는 항목에 붙일 정보이다
+type Acceptance struct {
+	Reason  string
+	Owner   string
+	Expires string
+}
+
+func Load(filename string) (*Baseline, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("베이스라인 파일 읽기 실패: %w", err)
+	}
+
+	var b Baseline
+	if err := yaml.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("베이스라인 파일 파싱 실패: %w", err)
+	}
+
+	for i, entry := range b.Entries {
+		if entry.Namespace == "" || entry.Kind == "" || entry.Name == "" {
+			return nil, fmt.Errorf("베이스라인 항목 %d: namespace, kind, name은 필수입니다 (클러스터 범위 리소스는 namespace: %q)", i+1, domain.ClusterScope)
+		}
+		if entry.Expires != "" {
+			if _, err := time.Parse(DateLayout, entry.Expires); err != nil {
+				return nil, fmt.Errorf("베이스라인 항목 %s: 잘못된 만료일 %q (형식: %s)", entry.key(), entry.Expires, DateLayout)
+			}
+		}
+	}
+
+	return &b, nil
+}
+
+// LoadOrEmpty는 파일이 없으면 빈 베이스라인을 반환한다
+func LoadOrEmpty(filename string) (*Baseline, error) {
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return &Baseline{}, nil
+	}
+	return Load(filename)
+}
+
+func (b *Baseline) Save(filename string) error {
+	b.sortEntries()
+
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Errorf("베이스라인 직렬화 실패: %w", err)
+	}
+
+	header := "# Argus 베이스라인: 수용된 수동 리소스 목록 (argus --write-baseline으로 생성)\n"
+	if err := os.WriteFile(filename, append([]byte(header), data...), 0644); err != nil {
+		return fmt.Errorf("베이스라인 파일 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// Record는 현재 분석 결과의 수동 리소스를 베이스라인에 기록한다.
+// 분석한 네임스페이스의 항목은 현재 결과로 대체되며, 이미 있던 항목은 사유/담당자/만료일을 유지한다.
+// 분석하지 않은 네임스페이스의 항목은 그대로 남고, 더 이상 발견되지 않는 항목은 제거된다.
+func (b *Baseline) Record(results map[string]domain.AnalysisResult, acceptance Acceptance, now time.Time) (added int) {
+	existing := make(map[string]Entry)
+	var kept []Entry
+	for _, entry := range b.Entries {
+		if _, scanned := results[entry.Namespace]; scanned {
+			existing[entry.key()] = entry
+			continue
+		}
+		kept = append(kept, entry)
+	}
+
+	for ns, result := range results {
+		for _, resource := range result.ManualResourceList {
+			id := resource.Identifier
+			key := entryKey(ns, id.Kind, id.Name)
+			if entry, ok := existing[key]; ok {
+				entry.APIVersion = id.APIVersion
+				kept = append(kept, entry)
+				delete(existing, key)
+				continue
+			}
+			kept = append(kept, Entry{
+				Namespace:  ns,
+				Kind:       id.Kind,
+				Name:       id.Name,
+				APIVersion: id.APIVersion,
+				Reason:     acceptance.Reason,
+				Owner:      acceptance.Owner,
+				Expires:    acceptance.Expires,
+				AddedAt:    now.Format(DateLayout),
+			})
+			added++
+		}
+	}
+
+	// 불완전하게 스캔된 네임스페이스의 항목은 실제로 사라졌는지 알 수 없으므로 유지한다
+	for _, entry := range existing {
+		if results[entry.Namespace].IsIncomplete() {
+			kept = append(kept, entry)
+		}
+	}
+
+	b.Entries = kept
+	b.sortEntries()
+	return added
+}
+
+// Apply는 베이스라인에 등록된 수동 리소스를 결과에서 제외하고,
+// 만료되었거나 더 이상 발견되지 않는 항목을 BaselineFindings로 기록한 새 결과를 반환한다.
+func (b *Baseline) Apply(results map[string]domain.AnalysisResult, now time.Time) map[string]domain.AnalysisResult {
+	entriesByNamespace := make(map[string]map[string]Entry)
+	for _, entry := range b.Entries {
+		if entriesByNamespace[entry.Namespace] == nil {
+			entriesByNamespace[entry.Namespace] = make(map[string]Entry)
+		}
+		entriesByNamespace[entry.Namespace][entry.key()] = entry
+	}
+
+	applied := make(map[string]domain.AnalysisResult, len(results))
+	for ns, result := range results {
+		entries := entriesByNamespace[ns]
+		if len(entries) == 0 {
+			applied[ns] = result
+			continue
+		}
+
+		matched := make(map[string]bool)
+		var remaining []domain.KubernetesResource
+		var findings []domain.BaselineFinding
+		suppressed := 0
+
+		for _, resource := range result.ManualResourceList {
+			key := entryKey(ns, resource.Identifier.Kind, resource.Identifier.Name)
+			entry, ok := entries[key]
+			if !ok {
+				remaining = append(remaining, resource)
+				continue
+			}
+
+			matched[key] = true
+			if entry.IsExpired(now) {
+				remaining = append(remaining, resource)
+				findings = append(findings, entry.finding(domain.BaselineExpired, resource.Identifier.APIVersion))
+				continue
+			}
+			suppressed++
+		}
+
+		// 일부 리소스 타입을 조회하지 못했다면 발견되지 않은 항목이 실제로 사라졌는지 알 수 없다
+		if !result.IsIncomplete() {
+			for key, entry := range entries {
+				if !matched[key] {
+					findings = append(findings, entry.finding(domain.BaselineStale, entry.APIVersion))
+				}
+			}
+		}
+
+		sortFindings(findings)
+		result.ManualResourceList = remaining
+		result.ManualResources = len(remaining)
+		result.BaselineSuppressed = suppressed
+		result.BaselineFindings = findings
+		applied[ns] = result
+	}
+
+	return applied
+}
+
+// IsExpired는 만료일이 지났는지 여부를 반환한다. 만료일 당일까지는 유효하다.
+func (e Entry) IsExpired(now time.Time) bool {
+	if e.Expires == "" {
+		return false
+	}
+	return now.Format(DateLayout) > e.Expires
+}
+
+func (e Entry) key() string {
+	return entryKey(e.Namespace, e.Kind, e.Name)
+}
+
+func (e Entry) finding(status, apiVersion string) domain.BaselineFinding {
+	return domain.BaselineFinding{
+		Identifier: domain.ResourceIdentifier{
+			APIVersion: apiVersion,
+			Kind:       e.Kind,
+			Name:       e.Name,
+			Namespace:  e.Namespace,
+		},
+		Status:  status,
+		Reason:  e.Reason,
+		Owner:   e.Owner,
+		Expires: e.Expires,
+	}
+}
+
+func entryKey(namespace, kind, name string) string {
+	return strings.Join([]string{namespace, kind, name}, "/")
+}
+
+func (b *Baseline) sortEntries() {
+	sort.Slice(b.Entries, func(i, j int) bool {
+		return b.Entries[i].key() < b.Entries[j].key()
+	})
+}
+
+func sortFindings(findings []domain.BaselineFinding) {
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i].Identifier, findings[j].Identifier
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+}
+
+// ValidateExpires는 --baseline-expires 값의 형식을 검사한다
+func ValidateExpires(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse(DateLayout, value); err != nil {
+		return fmt.Errorf("잘못된 만료일 %q (형식: %s)", value, DateLayout)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package baseline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func manualResource(namespace, kind, name string) domain.KubernetesResource {
+	return domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: kind, Name: name, Namespace: namespace},
+	}
+}
+
+func manualResult(resources ...domain.KubernetesResource) domain.AnalysisResult {
+	return domain.AnalysisResult{ManualResources: len(resources), ManualResourceList: resources}
+}
+
+func TestBaseline_Apply(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	b := &Baseline{Entries: []Entry{
+		{Namespace: "app", Kind: "Secret", Name: "bootstrap", Reason: "초기 부트스트랩", Owner: "sre"},
+		{Namespace: "app", Kind: "RoleBinding", Name: "break-glass", Reason: "비상 접근", Owner: "sre", Expires: "2024-05-31"},
+		{Namespace: "app", Kind: "ConfigMap", Name: "removed", Reason: "임시", Owner: "dev"},
+		{Namespace: "other", Kind: "Secret", Name: "unscanned", Reason: "미검사", Owner: "dev"},
+	}}
+
+	results := map[string]domain.AnalysisResult{
+		"app": manualResult(
+			manualResource("app", "Secret", "bootstrap"),
+			manualResource("app", "RoleBinding", "break-glass"),
+			manualResource("app", "Deployment", "hotfix"),
+		),
+		"clean": {},
+	}
+
+	applied := b.Apply(results, now)
+
+	app := applied["app"]
+	if app.ManualResources != 2 || len(app.ManualResourceList) != 2 {
+		t.Fatalf("ManualResources = %v, want 2 (%v)", app.ManualResources, app.ManualResourceList)
+	}
+	if app.BaselineSuppressed != 1 {
+		t.Errorf("BaselineSuppressed = %v, want 1", app.BaselineSuppressed)
+	}
+	if len(app.BaselineFindings) != 2 {
+		t.Fatalf("BaselineFindings = %v, want 2", app.BaselineFindings)
+	}
+	if f := app.BaselineFindings[0]; f.Identifier.Kind != "ConfigMap" || f.Status != domain.BaselineStale {
+		t.Errorf("BaselineFindings[0] = %+v, want stale ConfigMap", f)
+	}
+	if f := app.BaselineFindings[1]; f.Identifier.Kind != "RoleBinding" || f.Status != domain.BaselineExpired {
+		t.Errorf("BaselineFindings[1] = %+v, want expired RoleBinding", f)
+	}
+
+	if _, ok := applied["other"]; ok {
+		t.Error("검사하지 않은 네임스페이스가 결과에 추가되었습니다")
+	}
+	if results["app"].ManualResources != 3 {
+		t.Error("원본 결과가 변경되었습니다")
+	}
+}
+
+func TestBaseline_ApplyIncompleteScan(t *testing.T) {
+	b := &Baseline{Entries: []Entry{
+		{Namespace: "app", Kind: "Secret", Name: "bootstrap"},
+	}}
+	results := map[string]domain.AnalysisResult{
+		"app": {FailedResourceTypes: []domain.ResourceTypeFailure{{ResourceType: "secrets", Reason: "forbidden"}}},
+	}
+
+	applied := b.Apply(results, time.Now())
+
+	if len(applied["app"].BaselineFindings) != 0 {
+		t.Errorf("불완전한 스캔에서는 사라진 항목을 보고하지 않아야 합니다: %v", applied["app"].BaselineFindings)
+	}
+}
+
+func TestBaseline_Record(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	b := &Baseline{Entries: []Entry{
+		{Namespace: "app", Kind: "Secret", Name: "bootstrap", Reason: "기존 사유", Owner: "sre", AddedAt: "2024-01-01"},
+		{Namespace: "app", Kind: "ConfigMap", Name: "removed", Reason: "임시", Owner: "dev"},
+		{Namespace: "other", Kind: "Secret", Name: "unscanned", Reason: "미검사", Owner: "dev"},
+	}}
+	results := map[string]domain.AnalysisResult{
+		"app": manualResult(
+			manualResource("app", "Secret", "bootstrap"),
+			manualResource("app", "Deployment", "hotfix"),
+		),
+	}
+
+	added := b.Record(results, Acceptance{Reason: "신규 사유", Owner: "ops", Expires: "2024-12-31"}, now)
+
+	if added != 1 {
+		t.Errorf("added = %v, want 1", added)
+	}
+
+	want := []Entry{
+		{Namespace: "app", Kind: "Deployment", Name: "hotfix", APIVersion: "v1", Reason: "신규 사유", Owner: "ops", Expires: "2024-12-31", AddedAt: "2024-06-01"},
+		{Namespace: "app", Kind: "Secret", Name: "bootstrap", APIVersion: "v1", Reason: "기존 사유", Owner: "sre", AddedAt: "2024-01-01"},
+		{Namespace: "other", Kind: "Secret", Name: "unscanned", Reason: "미검사", Owner: "dev"},
+	}
+	if len(b.Entries) != len(want) {
+		t.Fatalf("Entries = %v, want %v", b.Entries, want)
+	}
+	for i := range want {
+		if b.Entries[i] != want[i] {
+			t.Errorf("Entries[%d] = %+v, want %+v", i, b.Entries[i], want[i])
+		}
+	}
+}
+
+func TestBaseline_ClusterScope(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	clusterRole := manualResource(domain.ClusterScope, "ClusterRole", "debug")
+
+	b := &Baseline{}
+	b.Record(map[string]domain.AnalysisResult{
+		"app":               {},
+		domain.ClusterScope: manualResult(clusterRole),
+	}, Acceptance{Reason: "디버깅", Owner: "sre"}, now)
+
+	// --cluster-scope 없이 기록해도 클러스터 범위 항목은 유지된다
+	b.Record(map[string]domain.AnalysisResult{"app": {}}, Acceptance{Reason: "재기록", Owner: "sre"}, now)
+	if len(b.Entries) != 1 || b.Entries[0].Namespace != domain.ClusterScope || b.Entries[0].Reason != "디버깅" {
+		t.Fatalf("Entries = %+v, want 클러스터 범위 ClusterRole/debug", b.Entries)
+	}
+
+	applied := b.Apply(map[string]domain.AnalysisResult{domain.ClusterScope: manualResult(clusterRole)}, now)
+	if cluster := applied[domain.ClusterScope]; cluster.ManualResources != 0 || cluster.BaselineSuppressed != 1 {
+		t.Errorf("클러스터 범위 결과 = %+v, want 베이스라인으로 1개 제외", cluster)
+	}
+}
+
+func TestBaseline_SaveAndLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "baseline.yaml")
+	b := &Baseline{Entries: []Entry{
+		{Namespace: "app", Kind: "Secret", Name: "bootstrap", Reason: "초기 부트스트랩", Owner: "sre", Expires: "2024-12-31", AddedAt: "2024-06-01"},
+	}}
+
+	if err := b.Save(filename); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(filename)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(loaded.Entries) != 1 || loaded.Entries[0] != b.Entries[0] {
+		t.Errorf("Load() = %+v, want %+v", loaded.Entries, b.Entries)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "필수 필드 누락",
+			content: "entries:\n  - namespace: app\n    kind: Secret\n",
+		},
+		{
+			name:    "잘못된 만료일",
+			content: "entries:\n  - namespace: app\n    kind: Secret\n    name: bootstrap\n    expires: 31/12/2024\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "baseline.yaml")
+			if err := os.WriteFile(filename, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(filename); err == nil {
+				t.Error("Load()가 에러를 반환하지 않았습니다")
+			}
+		})
+	}
+}
+
+func TestLoadOrEmpty_MissingFile(t *testing.T) {
+	b, err := LoadOrEmpty(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatalf("LoadOrEmpty() error = %v", err)
+	}
+	if len(b.Entries) != 0 {
+		t.Errorf("Entries = %v, want empty", b.Entries)
+	}
+}
+
+func TestEntry_IsExpired(t *testing.T) {
+	now := time.Date(2024, 6, 1, 23, 59, 0, 0, time.UTC)
+
+	if (Entry{}).IsExpired(now) {
+		t.Error("만료일이 없는 항목은 만료되지 않아야 합니다")
+	}
+	if (Entry{Expires: "2024-06-01"}).IsExpired(now) {
+		t.Error("만료일 당일에는 유효해야 합니다")
+	}
+	if !(Entry{Expires: "2024-05-31"}).IsExpired(now) {
+		t.Error("만료일이 지난 항목은 만료되어야 합니다")
+	}
+}
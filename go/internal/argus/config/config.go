@@ -1,9 +1,12 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -16,23 +19,41 @@ type Config struct {
 	Patterns      PatternsConfig      `yaml:"patterns"`
 	ResourceTypes ResourceTypesConfig `yaml:"resource_types"`
 	Performance   PerformanceConfig   `yaml:"performance"`
-
-	ExclusionRules         []ExclusionRule
-	SecretPatterns         []*regexp.Regexp
-	RancherManagedPatterns map[string][]*regexp.Regexp
-	AutoManagedAnnotations map[string]bool
-	CertManagerAnnotations map[string]bool
-	StatefulSetPVCPattern  *regexp.Regexp
-	SkipResourceTypes      map[string]bool
-	ImportantResourceTypes []string
-	BatchSize              int
+	Managers      []ManagerRule       `yaml:"managers"`
+	Selectors     SelectorsConfig     `yaml:"selectors"`
+
+	// 아래는 설정 파일에서 계산하거나 실행 옵션으로 채우는 값이며 YAML 키로 지정할 수 없다
+	ExclusionRules         []ExclusionRule             `yaml:"-"`
+	ResourceSelectors      SelectorSet                 `yaml:"-"`
+	NamespaceSelectors     SelectorSet                 `yaml:"-"`
+	SecretPatterns         []*regexp.Regexp            `yaml:"-"`
+	RancherManagedPatterns map[string][]*regexp.Regexp `yaml:"-"`
+	AutoManagedAnnotations map[string]bool             `yaml:"-"`
+	CertManagerAnnotations map[string]bool             `yaml:"-"`
+	StatefulSetPVCPattern  *regexp.Regexp              `yaml:"-"`
+	SkipResourceTypes      map[string]bool             `yaml:"-"`
+	ImportantResourceTypes []string                    `yaml:"-"`
+	BatchSize              int                         `yaml:"-"`
+	ScanClusterScope       bool                        `yaml:"-"`
+	SourceFile             string                      `yaml:"-"`
+	SourceHash             string                      `yaml:"-"`
+	// Warnings는 로드 중 무시한 규칙에 대한 경고이다 (argus config validate로 위치를 확인할 수 있다)
+	Warnings []string `yaml:"-"`
 }
 
 type ArgoCDConfig struct {
 	ManagedLabels   []string `yaml:"managed_labels"`
 	SyncAnnotations []string `yaml:"sync_annotations"`
+	// Namespace는 Application 리소스가 있는 ArgoCD 네임스페이스이다
+	Namespace string `yaml:"namespace"`
+	// FieldManagers에 지정한 필드 매니저가 managedFields에 있으면 라벨이 없어도 ArgoCD 관리로 본다
+	FieldManagers []string `yaml:"field_managers"`
+	// VerifyApplications가 켜져 있으면 tracking-id/instance 라벨이 가리키는 Application이 실제로 있는지 확인한다
+	VerifyApplications bool `yaml:"verify_applications"`
 }
 
+const DefaultArgoCDNamespace = "argocd"
+
 type AutoManagedConfig struct {
 	Annotations            []string `yaml:"annotations"`
 	CertManagerAnnotations []string `yaml:"cert_manager_annotations"`
@@ -55,7 +76,8 @@ type PerformanceConfig struct {
 	BatchSize            int `yaml:"batch_size"`
 }
 
-var DefaultMaxConcurrent = 10
+// DefaultMaxConcurrent는 설정 파일에 performance.default_max_concurrent가 없을 때의 동시 처리 수이다
+const DefaultMaxConcurrent = 10
 
 func NewDefaultConfig() *Config {
 	return &Config{}
@@ -72,11 +94,24 @@ func LoadConfigFromFile(filename string) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
 	}
 
+	// 여러 규칙이 일치할 때 항상 같은 규칙으로 설명되도록 카테고리 순서를 고정한다
+	categories := make([]string, 0, len(cfg.Exclusions))
+	for category := range cfg.Exclusions {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
 	var exclusionRules []ExclusionRule
-	for _, patterns := range cfg.Exclusions {
-		for _, pattern := range patterns {
+	for _, category := range categories {
+		for _, pattern := range cfg.Exclusions[category] {
 			parts := strings.Split(pattern, "/")
-			if len(parts) != 3 {
+			// 기존 설정 파일이 계속 동작하도록 잘못된 제외 패턴은 실패 대신 경고를 남기고 건너뛴다
+			if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+				cfg.Warnings = append(cfg.Warnings, fmt.Sprintf("ignoring exclusion pattern %s in exclusions.%s: expected <namespace>/<kind>/<name>", pattern, category))
+				continue
+			}
+			if err := validateExclusionParts(parts); err != nil {
+				cfg.Warnings = append(cfg.Warnings, fmt.Sprintf("ignoring exclusion pattern %s in exclusions.%s: %v", pattern, category, err))
 				continue
 			}
 			exclusionRules = append(exclusionRules, ExclusionRule{
@@ -84,11 +119,25 @@ func LoadConfigFromFile(filename string) (*Config, error) {
 				Kind:      parts[1],
 				Name:      parts[2],
 				Pattern:   pattern,
+				Category:  category,
 			})
 		}
 	}
 	cfg.ExclusionRules = exclusionRules
 
+	if cfg.ResourceSelectors, err = NewSelectorSet("resources", cfg.Selectors.Resources); err != nil {
+		return nil, err
+	}
+	if cfg.NamespaceSelectors, err = NewSelectorSet("namespaces", cfg.Selectors.Namespaces); err != nil {
+		return nil, err
+	}
+
+	for i := range cfg.Managers {
+		if err := cfg.Managers[i].validate(i); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, pattern := range cfg.Patterns.SecretPatterns {
 		re, err := regexp.Compile(pattern)
 		if err != nil {
@@ -133,11 +182,22 @@ func LoadConfigFromFile(filename string) (*Config, error) {
 
 	cfg.ImportantResourceTypes = cfg.ResourceTypes.Important
 	cfg.BatchSize = cfg.Performance.BatchSize
-	DefaultMaxConcurrent = cfg.Performance.DefaultMaxConcurrent
+
+	sum := sha256.Sum256(data)
+	cfg.SourceFile = filename
+	cfg.SourceHash = hex.EncodeToString(sum[:])
 
 	return &cfg, nil
 }
 
+// MaxConcurrent는 -P를 지정하지 않았을 때 사용할 동시 처리 수이다
+func (c *Config) MaxConcurrent() int {
+	if c.Performance.DefaultMaxConcurrent > 0 {
+		return c.Performance.DefaultMaxConcurrent
+	}
+	return DefaultMaxConcurrent
+}
+
 func (c *Config) GetManagedLabels() []string {
 	return c.ArgoCD.ManagedLabels
 }
@@ -145,3 +205,15 @@ func (c *Config) GetManagedLabels() []string {
 func (c *Config) GetSyncAnnotations() []string {
 	return c.ArgoCD.SyncAnnotations
 }
+
+// MatchArgoCDFieldManager는 fieldManagers 중 하나와 일치한 argocd.field_managers 패턴을 반환한다
+func (c *Config) MatchArgoCDFieldManager(fieldManagers []string) string {
+	return matchFieldManagers(c.ArgoCD.FieldManagers, fieldManagers)
+}
+
+func (c *Config) GetArgoCDNamespace() string {
+	if c.ArgoCD.Namespace == "" {
+		return DefaultArgoCDNamespace
+	}
+	return c.ArgoCD.Namespace
+}
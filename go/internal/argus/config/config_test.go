@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -80,6 +81,20 @@ patterns:
 `,
 			wantErr: true,
 		},
+		{
+			name: "잘못된 제외 규칙 정규식은 경고 후 무시",
+			content: `
+exclusions:
+  default_patterns:
+    - "app/Secret/re:(token"
+`,
+			wantErr: false,
+			check: func(t *testing.T, cfg *Config) {
+				if len(cfg.ExclusionRules) != 0 || len(cfg.Warnings) != 1 {
+					t.Errorf("ExclusionRules = %v, Warnings = %v, want 규칙 0개, 경고 1개", cfg.ExclusionRules, cfg.Warnings)
+				}
+			},
+		},
 		{
 			name:    "빈 설정 파일",
 			content: ``,
@@ -122,6 +137,80 @@ func TestLoadConfigFromFile_FileNotFound(t *testing.T) {
 	}
 }
 
+func TestLoadConfigFromFile_Managers(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name: "올바른 관리 도구 규칙",
+			content: `
+managers:
+  - name: Helm
+    labels:
+      app.kubernetes.io/managed-by: Helm
+`,
+		},
+		{
+			name: "이름 누락",
+			content: `
+managers:
+  - labels:
+      app.kubernetes.io/managed-by: Helm
+`,
+			wantErr: true,
+		},
+		{
+			name: "시그니처 누락",
+			content: `
+managers:
+  - name: Terraform
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "rules.yaml")
+			if err := os.WriteFile(filename, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := LoadConfigFromFile(filename)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfigFromFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if rule, _, _ := cfg.DetectManagerRule(map[string]string{"app.kubernetes.io/managed-by": "Helm"}, nil, nil); rule == nil || rule.Name != "Helm" {
+				t.Error("관리 도구 규칙이 로드되지 않았습니다")
+			}
+		})
+	}
+}
+
+func TestLoadConfigFromFile_SourceHash(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configFile, []byte("performance:\n  batch_size: 10\n"), 0644); err != nil {
+		t.Fatalf("테스트 파일 생성 실패: %v", err)
+	}
+
+	cfg, err := LoadConfigFromFile(configFile)
+	if err != nil {
+		t.Fatalf("LoadConfigFromFile() error = %v", err)
+	}
+
+	if cfg.SourceFile != configFile {
+		t.Errorf("SourceFile = %v, want %v", cfg.SourceFile, configFile)
+	}
+	if len(cfg.SourceHash) != 64 {
+		t.Errorf("SourceHash 길이 = %v, want 64", len(cfg.SourceHash))
+	}
+}
+
 func TestExclusionRuleParsing(t *testing.T) {
 	content := `
 exclusions:
@@ -129,7 +218,6 @@ exclusions:
     - "ns1/Kind1/name1"
     - "ns2/Kind2/*"
     - "*/Kind3/prefix*"
-    - "invalid-pattern"
 `
 	tmpDir := t.TempDir()
 	configFile := filepath.Join(tmpDir, "config.yaml")
@@ -169,6 +257,44 @@ exclusions:
 		if rule.Name != expected.name {
 			t.Errorf("ExclusionRules[%d].Name = %v, want %v", i, rule.Name, expected.name)
 		}
+		if rule.Category != "patterns" {
+			t.Errorf("ExclusionRules[%d].Category = %v, want patterns", i, rule.Category)
+		}
+	}
+}
+
+func TestExclusionRuleParsing_InvalidFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{name: "구분자 없음", pattern: "invalid-pattern"},
+		{name: "구분자 부족", pattern: "ns1/Kind1"},
+		{name: "구분자 초과", pattern: "ns1/Kind1/name1/extra"},
+		{name: "빈 부분", pattern: "ns1//name1"},
+		{name: "해석할 수 없는 글로브", pattern: "ns1/Kind1/data-[0-9"},
+		{name: "해석할 수 없는 정규식", pattern: "ns1/Kind1/re:(token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "config.yaml")
+			content := "exclusions:\n  patterns:\n    - \"ns2/Kind2/name2\"\n    - \"" + tt.pattern + "\"\n"
+			if err := os.WriteFile(configFile, []byte(content), 0644); err != nil {
+				t.Fatalf("테스트 파일 생성 실패: %v", err)
+			}
+
+			cfg, err := LoadConfigFromFile(configFile)
+			if err != nil {
+				t.Fatalf("LoadConfigFromFile() error = %v, 잘못된 제외 패턴은 건너뛰어야 합니다", err)
+			}
+			if len(cfg.ExclusionRules) != 1 || cfg.ExclusionRules[0].Pattern != "ns2/Kind2/name2" {
+				t.Errorf("ExclusionRules = %+v, want ns2/Kind2/name2만", cfg.ExclusionRules)
+			}
+			if len(cfg.Warnings) != 1 || !strings.Contains(cfg.Warnings[0], tt.pattern) {
+				t.Errorf("Warnings = %v, want %q에 대한 경고 1개", cfg.Warnings, tt.pattern)
+			}
+		})
 	}
 }
 
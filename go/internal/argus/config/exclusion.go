@@ -1,14 +1,12 @@
 package config
 
-import (
-	"strings"
-)
-
 type ExclusionRule struct {
 	Namespace string
 	Kind      string
 	Name      string
 	Pattern   string
+	// Category는 규칙이 정의된 exclusions 아래의 키이다 (예: "kube_system", "others")
+	Category string
 }
 
 func (r *ExclusionRule) Match(namespace, kind, name string) bool {
@@ -26,28 +24,3 @@ func (r *ExclusionRule) Match(namespace, kind, name string) bool {
 
 	return true
 }
-
-func matchPattern(pattern, value string) bool {
-	if pattern == "*" {
-		return true
-	}
-
-	if strings.HasSuffix(pattern, "*") {
-		prefix := pattern[:len(pattern)-1]
-		return strings.HasPrefix(value, prefix)
-	}
-
-	if strings.HasPrefix(pattern, "*") {
-		suffix := pattern[1:]
-		return strings.HasSuffix(value, suffix)
-	}
-
-	if strings.Contains(pattern, "*") {
-		parts := strings.Split(pattern, "*")
-		if len(parts) == 2 {
-			return strings.HasPrefix(value, parts[0]) && strings.HasSuffix(value, parts[1])
-		}
-	}
-
-	return pattern == value
-}
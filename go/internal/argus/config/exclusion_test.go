@@ -210,10 +210,82 @@ func TestMatchPattern(t *testing.T) {
 			want:    false,
 		},
 		{
-			name:    "여러 와일드카드 - 첫 번째 두 개만 처리",
+			name:    "여러 와일드카드",
 			pattern: "a*b*c",
 			value:   "aXbYc",
-			want:    false, // 두 개의 와일드카드만 처리하므로 false
+			want:    true,
+		},
+		{
+			name:    "양쪽 와일드카드",
+			pattern: "*-cache-*",
+			value:   "redis-cache-0",
+			want:    true,
+		},
+		{
+			name:    "여러 와일드카드 불일치",
+			pattern: "team-*-prod",
+			value:   "team-a-staging",
+			want:    false,
+		},
+		{
+			name:    "한 글자 와일드카드",
+			pattern: "web-?",
+			value:   "web-1",
+			want:    true,
+		},
+		{
+			name:    "한 글자 와일드카드 불일치",
+			pattern: "web-?",
+			value:   "web-10",
+			want:    false,
+		},
+		{
+			name:    "문자 클래스 범위",
+			pattern: "data-[0-9]",
+			value:   "data-3",
+			want:    true,
+		},
+		{
+			name:    "부정 문자 클래스",
+			pattern: "data-[!0-9]",
+			value:   "data-3",
+			want:    false,
+		},
+		{
+			name:    "이스케이프한 메타 문자",
+			pattern: `literal\*`,
+			value:   "literal-x",
+			want:    false,
+		},
+		{
+			name:    "정규식 패턴",
+			pattern: "re:^token-[a-z0-9]{5}$",
+			value:   "token-ab12c",
+			want:    true,
+		},
+		{
+			name:    "정규식은 부분 일치",
+			pattern: "re:cache",
+			value:   "redis-cache-0",
+			want:    true,
+		},
+		{
+			name:    "정규식 불일치",
+			pattern: "re:^token-[a-z0-9]{5}$",
+			value:   "token-ab12cd",
+			want:    false,
+		},
+		{
+			name:    "와일드카드는 '/'도 포함",
+			pattern: "*/managed-by",
+			value:   "app.kubernetes.io/managed-by",
+			want:    true,
+		},
+		{
+			name:    "해석할 수 없는 패턴은 정확한 일치만",
+			pattern: "broken-[",
+			value:   "broken-[",
+			want:    true,
 		},
 		{
 			name:    "와일드카드 접두사와 정확한 일치",
@@ -243,3 +315,26 @@ func TestMatchPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{"plain-name", false},
+		{"team-*-prod", false},
+		{"[a-z]?x", false},
+		{"[]a]", false},
+		{"unclosed-[", true},
+		{"[z-a]", true},
+		{`trailing\`, true},
+		{"re:^ok$", false},
+		{"re:(unclosed", true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidatePattern(tt.pattern); (err != nil) != tt.wantErr {
+			t.Errorf("ValidatePattern(%q) error = %v, wantErr %v", tt.pattern, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ManagerRule은 라벨/어노테이션/필드 매니저 시그니처로 ArgoCD 외의 관리 도구(Helm, Flux, Terraform 등)를 식별한다.
+// 키와 값에는 exclusions와 같은 '*' 와일드카드를 쓸 수 있으며, 시그니처 중 하나라도 맞으면 해당 도구가 관리하는 것으로 본다.
+type ManagerRule struct {
+	Name        string            `yaml:"name"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+	// FieldManagers는 managedFields의 필드 매니저 이름 패턴이다 (예: "helm", "terraform-provider-*")
+	FieldManagers []string `yaml:"field_managers"`
+}
+
+// MatchSignature는 일치한 시그니처의 필드 이름(labels, annotations, field_managers)과 패턴을 반환한다.
+// 일치하는 시그니처가 없으면 빈 문자열을 반환한다.
+func (r *ManagerRule) MatchSignature(labels, annotations map[string]string, fieldManagers []string) (string, string) {
+	if signature := matchSignatures(r.Labels, labels); signature != "" {
+		return "labels", signature
+	}
+	if signature := matchSignatures(r.Annotations, annotations); signature != "" {
+		return "annotations", signature
+	}
+	if pattern := matchFieldManagers(r.FieldManagers, fieldManagers); pattern != "" {
+		return "field_managers", pattern
+	}
+	return "", ""
+}
+
+func matchFieldManagers(patterns, fieldManagers []string) string {
+	for _, pattern := range patterns {
+		for _, manager := range fieldManagers {
+			if matchPattern(pattern, manager) {
+				return pattern
+			}
+		}
+	}
+	return ""
+}
+
+// matchSignatures는 일치한 시그니처를 "키=값" 형식으로 반환한다 (값 패턴이 없으면 키만)
+func matchSignatures(signatures, values map[string]string) string {
+	for _, keyPattern := range sortedSignatureKeys(signatures) {
+		valuePattern := signatures[keyPattern]
+		for key, value := range values {
+			if !matchPattern(keyPattern, key) {
+				continue
+			}
+			if valuePattern == "" {
+				return keyPattern
+			}
+			if matchPattern(strings.ToLower(valuePattern), strings.ToLower(value)) {
+				return keyPattern + "=" + valuePattern
+			}
+		}
+	}
+	return ""
+}
+
+// 여러 시그니처가 일치할 때 항상 같은 규칙으로 설명되도록 키 순서를 고정한다
+func sortedSignatureKeys(signatures map[string]string) []string {
+	keys := make([]string, 0, len(signatures))
+	for key := range signatures {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r *ManagerRule) validate(index int) error {
+	if r.Name == "" {
+		return fmt.Errorf("managers[%d]: name은 필수입니다", index)
+	}
+	if len(r.Labels) == 0 && len(r.Annotations) == 0 && len(r.FieldManagers) == 0 {
+		return fmt.Errorf("managers[%d] (%s): labels, annotations, field_managers 중 하나는 지정해야 합니다", index, r.Name)
+	}
+	return nil
+}
+
+// DetectManagerRule은 처음 일치하는 관리 도구 규칙과 일치한 시그니처의 필드 이름, 패턴을 반환한다
+func (c *Config) DetectManagerRule(labels, annotations map[string]string, fieldManagers []string) (*ManagerRule, string, string) {
+	for i := range c.Managers {
+		if field, pattern := c.Managers[i].MatchSignature(labels, annotations, fieldManagers); field != "" {
+			return &c.Managers[i], field, pattern
+		}
+	}
+	return nil, "", ""
+}
@@ -0,0 +1,128 @@
+package config
+
+import "testing"
+
+func TestManagerRuleMatch(t *testing.T) {
+	helm := ManagerRule{
+		Name:        "Helm",
+		Labels:      map[string]string{"app.kubernetes.io/managed-by": "Helm"},
+		Annotations: map[string]string{"meta.helm.sh/release-name": "*"},
+	}
+	flux := ManagerRule{
+		Name:   "Flux",
+		Labels: map[string]string{"kustomize.toolkit.fluxcd.io/*": "", "helm.toolkit.fluxcd.io/*": ""},
+	}
+	kustomize := ManagerRule{
+		Name:   "Kustomize",
+		Labels: map[string]string{"app.kubernetes.io/managed-by": "kustomize-*"},
+	}
+	terraform := ManagerRule{
+		Name:          "Terraform",
+		FieldManagers: []string{"terraform-provider-*", "Terraform"},
+	}
+
+	tests := []struct {
+		name          string
+		rule          ManagerRule
+		labels        map[string]string
+		annotations   map[string]string
+		fieldManagers []string
+		want          bool
+	}{
+		{
+			name:   "라벨 값 일치 (대소문자 무시)",
+			rule:   helm,
+			labels: map[string]string{"app.kubernetes.io/managed-by": "helm"},
+			want:   true,
+		},
+		{
+			name:        "어노테이션 존재",
+			rule:        helm,
+			annotations: map[string]string{"meta.helm.sh/release-name": "my-release"},
+			want:        true,
+		},
+		{
+			name:   "라벨 값 불일치",
+			rule:   helm,
+			labels: map[string]string{"app.kubernetes.io/managed-by": "Terraform"},
+			want:   false,
+		},
+		{
+			name:   "키 접두사 와일드카드",
+			rule:   flux,
+			labels: map[string]string{"kustomize.toolkit.fluxcd.io/name": "apps"},
+			want:   true,
+		},
+		{
+			name:   "값 접두사 와일드카드",
+			rule:   kustomize,
+			labels: map[string]string{"app.kubernetes.io/managed-by": "kustomize-v5.0.1"},
+			want:   true,
+		},
+		{
+			name:          "필드 매니저 와일드카드",
+			rule:          terraform,
+			fieldManagers: []string{"kubectl-client-side-apply", "terraform-provider-kubernetes"},
+			want:          true,
+		},
+		{
+			name:          "필드 매니저 불일치",
+			rule:          terraform,
+			fieldManagers: []string{"kubectl-edit"},
+			want:          false,
+		},
+		{
+			name: "시그니처 없음",
+			rule: flux,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, pattern := tt.rule.MatchSignature(tt.labels, tt.annotations, tt.fieldManagers)
+			if got := field != ""; got != tt.want {
+				t.Errorf("MatchSignature() = %q, %q, want 일치 %v", field, pattern, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_DetectManagerRule(t *testing.T) {
+	cfg := &Config{Managers: []ManagerRule{
+		{Name: "Flux", Labels: map[string]string{"helm.toolkit.fluxcd.io/*": ""}},
+		{Name: "Helm", Labels: map[string]string{"app.kubernetes.io/managed-by": "Helm"}},
+	}}
+
+	fluxHelm := map[string]string{
+		"app.kubernetes.io/managed-by": "Helm",
+		"helm.toolkit.fluxcd.io/name":  "podinfo",
+	}
+	rule, field, pattern := cfg.DetectManagerRule(fluxHelm, nil, nil)
+	if rule == nil || rule.Name != "Flux" {
+		t.Fatalf("DetectManagerRule() = %v, want Flux (규칙 순서 우선)", rule)
+	}
+	if field != "labels" || pattern != "helm.toolkit.fluxcd.io/*" {
+		t.Errorf("DetectManagerRule() 시그니처 = %s %s, want labels helm.toolkit.fluxcd.io/*", field, pattern)
+	}
+	if rule, _, _ := cfg.DetectManagerRule(map[string]string{"app": "web"}, nil, nil); rule != nil {
+		t.Errorf("DetectManagerRule() = %v, want nil", rule)
+	}
+}
+
+func TestConfig_MatchArgoCDFieldManager(t *testing.T) {
+	cfg := &Config{ArgoCD: ArgoCDConfig{FieldManagers: []string{"argocd-controller", "argocd-application-*"}}}
+
+	if got := cfg.MatchArgoCDFieldManager([]string{"kubectl-create", "argocd-controller"}); got != "argocd-controller" {
+		t.Error("argocd-controller는 ArgoCD 필드 매니저로 판정되어야 합니다")
+	}
+	if got := cfg.MatchArgoCDFieldManager([]string{"argocd-application-controller"}); got != "argocd-application-*" {
+		t.Error("와일드카드 패턴이 적용되어야 합니다")
+	}
+	if got := cfg.MatchArgoCDFieldManager([]string{"kubectl-edit"}); got != "" {
+		t.Error("kubectl-edit는 ArgoCD 필드 매니저가 아닙니다")
+	}
+	if got := (&Config{}).MatchArgoCDFieldManager([]string{"argocd-controller"}); got != "" {
+		t.Error("field_managers를 지정하지 않으면 필드 매니저로 판정하지 않아야 합니다")
+	}
+}
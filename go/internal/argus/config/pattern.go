@@ -0,0 +1,159 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RegexPrefix로 시작하는 패턴은 글로브 대신 정규식으로 해석한다 (예: "re:^team-.+-prod$")
+const RegexPrefix = "re:"
+
+// 패턴은 리소스마다 반복해서 비교되므로 컴파일 결과를 패턴 문자열 단위로 재사용한다.
+// 컴파일할 수 없는 패턴은 nil로 저장하며 문자열이 완전히 같은 값과만 일치한다.
+var compiledPatterns sync.Map // string -> *regexp.Regexp
+
+// matchPattern은 글로브(*, ?, [abc], [a-z], [!abc]) 또는 re: 정규식 패턴으로 값을 비교한다.
+// 글로브의 *는 '/'를 포함한 임의의 문자열과 일치하므로 라벨/어노테이션 키에도 그대로 쓸 수 있다.
+func matchPattern(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if !isPattern(pattern) {
+		return pattern == value
+	}
+
+	re := lookupPattern(pattern)
+	if re == nil {
+		return pattern == value
+	}
+	return re.MatchString(value)
+}
+
+func lookupPattern(pattern string) *regexp.Regexp {
+	if cached, ok := compiledPatterns.Load(pattern); ok {
+		return cached.(*regexp.Regexp)
+	}
+	re, _ := compilePattern(pattern)
+	compiledPatterns.Store(pattern, re)
+	return re
+}
+
+// isPattern은 문자열 그대로 비교하면 되는 값인지 구분한다
+func isPattern(pattern string) bool {
+	return strings.HasPrefix(pattern, RegexPrefix) || strings.ContainsAny(pattern, `*?[\`)
+}
+
+// compilePattern은 re: 정규식은 그대로(부분 일치), 글로브는 전체 일치하는 정규식으로 컴파일한다
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if expr, ok := strings.CutPrefix(pattern, RegexPrefix); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 정규식 '%s': %w", expr, err)
+		}
+		return re, nil
+	}
+
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 글로브 '%s': %w", pattern, err)
+	}
+	return re, nil
+}
+
+func globToRegexp(glob string) (string, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 == len(glob) {
+				return "", fmt.Errorf("잘못된 글로브 '%s': 끝에 이스케이프할 문자가 없습니다", glob)
+			}
+			i++
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case '[':
+			end := classEnd(glob, i)
+			if end < 0 {
+				return "", fmt.Errorf("잘못된 글로브 '%s': 닫히지 않은 '['", glob)
+			}
+			b.WriteString(classToRegexp(glob[i+1 : end]))
+			i = end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String(), nil
+}
+
+// classEnd는 glob[start]의 '['와 짝이 되는 ']'의 위치를 반환하며, 없으면 -1이다.
+// 부정 기호 바로 뒤나 맨 앞의 ']'는 문자 자체로 취급한다.
+func classEnd(glob string, start int) int {
+	i := start + 1
+	if i < len(glob) && (glob[i] == '!' || glob[i] == '^') {
+		i++
+	}
+	if i < len(glob) && glob[i] == ']' {
+		i++
+	}
+	for ; i < len(glob); i++ {
+		switch glob[i] {
+		case '\\':
+			i++
+		case ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func classToRegexp(class string) string {
+	var b strings.Builder
+	b.WriteString("[")
+	if strings.HasPrefix(class, "!") || strings.HasPrefix(class, "^") {
+		b.WriteString("^")
+		class = class[1:]
+	}
+	for i := 0; i < len(class); i++ {
+		switch c := class[i]; c {
+		case '\\':
+			i++
+			if i < len(class) {
+				b.WriteString(regexp.QuoteMeta(class[i : i+1]))
+			}
+		case '-':
+			// 범위 표현은 그대로 두고, 맨 앞/뒤의 '-'는 문자 자체로 취급한다
+			if i == 0 || i == len(class)-1 {
+				b.WriteString(`\-`)
+			} else {
+				b.WriteByte('-')
+			}
+		case '[', ']', '^':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// ValidatePattern은 패턴이 글로브 또는 re: 정규식으로 해석 가능한지 확인한다
+func ValidatePattern(pattern string) error {
+	if !isPattern(pattern) {
+		return nil
+	}
+	_, err := compilePattern(pattern)
+	return err
+}
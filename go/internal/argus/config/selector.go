@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SelectorsConfig는 이름 대신 라벨/어노테이션으로 리소스와 네임스페이스를 제외하거나 스캔 대상을 한정한다.
+// 각 항목은 Kubernetes 라벨 셀렉터 문법("key=value", "key!=value", "key in (a,b)", "key", "!key")이며,
+// 한 항목 안의 쉼표는 AND, 목록의 항목끼리는 OR로 해석한다.
+type SelectorsConfig struct {
+	Resources  SelectorRules `yaml:"resources"`
+	Namespaces SelectorRules `yaml:"namespaces"`
+}
+
+type SelectorRules struct {
+	ExcludeLabels      []string `yaml:"exclude_labels"`
+	ExcludeAnnotations []string `yaml:"exclude_annotations"`
+	// include 선택자가 하나라도 있으면 그중 하나와 일치하는 대상만 스캔한다
+	IncludeLabels      []string `yaml:"include_labels"`
+	IncludeAnnotations []string `yaml:"include_annotations"`
+}
+
+type Selector struct {
+	// Section은 선택자가 정의된 위치이다 (예: "selectors.resources.exclude_labels")
+	Section    string
+	Expression string
+	// Annotations가 true이면 라벨 대신 어노테이션에 셀렉터를 적용한다
+	Annotations bool
+	selector    labels.Selector
+}
+
+func (s *Selector) Matches(objectLabels, objectAnnotations map[string]string) bool {
+	if s.Annotations {
+		return s.selector.Matches(labels.Set(objectAnnotations))
+	}
+	return s.selector.Matches(labels.Set(objectLabels))
+}
+
+type SelectorSet struct {
+	// scope는 결정 규칙에 표시되는 위치이다 ("resources" 또는 "namespaces")
+	scope   string
+	Exclude []Selector
+	Include []Selector
+}
+
+func (s *SelectorSet) IsEmpty() bool {
+	return len(s.Exclude) == 0 && len(s.Include) == 0
+}
+
+// Excluded는 대상을 제외하는 선택자의 위치와 식을 반환하며, 제외 대상이 아니면 빈 문자열을 반환한다.
+// include 선택자가 있는데 어느 것과도 일치하지 않으면 "selectors.<scope>.include"를 반환한다.
+func (s *SelectorSet) Excluded(objectLabels, objectAnnotations map[string]string) (string, string) {
+	for i := range s.Exclude {
+		if s.Exclude[i].Matches(objectLabels, objectAnnotations) {
+			return s.Exclude[i].Section, s.Exclude[i].Expression
+		}
+	}
+
+	if len(s.Include) == 0 {
+		return "", ""
+	}
+	for i := range s.Include {
+		if s.Include[i].Matches(objectLabels, objectAnnotations) {
+			return "", ""
+		}
+	}
+	return "selectors." + s.scope + ".include", "include 선택자와 일치하지 않음"
+}
+
+// NewSelectorSet은 scope("resources" 또는 "namespaces") 아래의 셀렉터 문자열을 파싱한다
+func NewSelectorSet(scope string, rules SelectorRules) (SelectorSet, error) {
+	set := SelectorSet{scope: scope}
+	groups := []struct {
+		field       string
+		expressions []string
+		annotations bool
+		include     bool
+	}{
+		{"exclude_labels", rules.ExcludeLabels, false, false},
+		{"exclude_annotations", rules.ExcludeAnnotations, true, false},
+		{"include_labels", rules.IncludeLabels, false, true},
+		{"include_annotations", rules.IncludeAnnotations, true, true},
+	}
+
+	for _, group := range groups {
+		section := fmt.Sprintf("selectors.%s.%s", scope, group.field)
+		for _, expression := range group.expressions {
+			selector, err := labels.Parse(expression)
+			if err != nil {
+				return SelectorSet{}, fmt.Errorf("invalid selector %s (%s): %w", expression, section, err)
+			}
+			compiled := Selector{Section: section, Expression: expression, Annotations: group.annotations, selector: selector}
+			if group.include {
+				set.Include = append(set.Include, compiled)
+			} else {
+				set.Exclude = append(set.Exclude, compiled)
+			}
+		}
+	}
+	return set, nil
+}
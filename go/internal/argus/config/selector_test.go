@@ -0,0 +1,76 @@
+package config
+
+import "testing"
+
+func TestSelectorSet_Excluded(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       SelectorRules
+		labels      map[string]string
+		annotations map[string]string
+		wantSection string
+	}{
+		{
+			name:  "선택자 없음",
+			rules: SelectorRules{},
+		},
+		{
+			name:        "라벨 제외 선택자 일치",
+			rules:       SelectorRules{ExcludeLabels: []string{"app.kubernetes.io/part-of=platform"}},
+			labels:      map[string]string{"app.kubernetes.io/part-of": "platform"},
+			wantSection: "selectors.resources.exclude_labels",
+		},
+		{
+			name:   "라벨 제외 선택자 불일치",
+			rules:  SelectorRules{ExcludeLabels: []string{"app.kubernetes.io/part-of=platform"}},
+			labels: map[string]string{"app.kubernetes.io/part-of": "payments"},
+		},
+		{
+			name:        "어노테이션 제외 선택자",
+			rules:       SelectorRules{ExcludeAnnotations: []string{"ops.bellsoft/ignore-argus=true"}},
+			labels:      map[string]string{"ops.bellsoft/ignore-argus": "false"},
+			annotations: map[string]string{"ops.bellsoft/ignore-argus": "true"},
+			wantSection: "selectors.resources.exclude_annotations",
+		},
+		{
+			name:   "쉼표는 AND",
+			rules:  SelectorRules{ExcludeLabels: []string{"tier=cache,env in (dev,stage)"}},
+			labels: map[string]string{"tier": "cache", "env": "prod"},
+		},
+		{
+			name:   "include 선택자 일치",
+			rules:  SelectorRules{IncludeLabels: []string{"team=payments"}, IncludeAnnotations: []string{"owner"}},
+			labels: map[string]string{"team": "payments"},
+		},
+		{
+			name:        "include 선택자와 불일치",
+			rules:       SelectorRules{IncludeLabels: []string{"team=payments"}},
+			labels:      map[string]string{"team": "search"},
+			wantSection: "selectors.resources.include",
+		},
+		{
+			name:        "제외 선택자가 include보다 우선",
+			rules:       SelectorRules{ExcludeLabels: []string{"!team"}, IncludeAnnotations: []string{"owner"}},
+			annotations: map[string]string{"owner": "sre"},
+			wantSection: "selectors.resources.exclude_labels",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := NewSelectorSet("resources", tt.rules)
+			if err != nil {
+				t.Fatalf("NewSelectorSet() error = %v", err)
+			}
+			if section, _ := set.Excluded(tt.labels, tt.annotations); section != tt.wantSection {
+				t.Errorf("Excluded() = %q, want %q", section, tt.wantSection)
+			}
+		})
+	}
+}
+
+func TestNewSelectorSet_InvalidExpression(t *testing.T) {
+	if _, err := NewSelectorSet("namespaces", SelectorRules{IncludeLabels: []string{"team in (payments"}}); err == nil {
+		t.Error("잘못된 셀렉터에서 에러를 반환해야 합니다")
+	}
+}
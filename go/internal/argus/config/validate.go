@@ -0,0 +1,344 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Issue는 설정 파일 검증에서 발견한 문제 하나이다. Line이 0이면 위치를 알 수 없는 문제이다.
+type Issue struct {
+	Line     int
+	Severity string
+	Message  string
+}
+
+func (i Issue) IsError() bool {
+	return i.Severity == SeverityError
+}
+
+// Validate는 LoadConfigFromFile과 달리 설정 파일을 엄격하게 검사한다.
+// 알 수 없는 키, 해석할 수 없는 글로브/정규식/셀렉터, 잘못된 managers 규칙은 오류로,
+// 스캔 시 무시되는 잘못된 제외 패턴과 다른 규칙에 포함되어 효과가 없는 제외 규칙은 경고로 보고한다.
+func Validate(data []byte) []Issue {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return yamlErrorIssues(err)
+	}
+
+	var issues []Issue
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var cfg Config
+	if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		issues = append(issues, yamlErrorIssues(err)...)
+	}
+
+	doc := documentRoot(&root)
+	issues = append(issues, validateExclusions(mappingValue(doc, "exclusions"))...)
+	issues = append(issues, validatePatterns(mappingValue(doc, "patterns"))...)
+	issues = append(issues, validateManagers(mappingValue(doc, "managers"))...)
+	issues = append(issues, validateSelectors(mappingValue(doc, "selectors"))...)
+	issues = append(issues, validateGlobs("argocd.field_managers", sequenceItems(mappingValue(mappingValue(doc, "argocd"), "field_managers")))...)
+
+	sortIssues(issues)
+	return issues
+}
+
+// ValidateResourceTypes는 resource_types.skip/important 항목 중 served(클러스터가 제공하는 타입 이름)에 없는 항목을 경고로 보고한다
+func ValidateResourceTypes(data []byte, served []string) []Issue {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil
+	}
+
+	servedTypes := make(map[string]bool, len(served))
+	for _, name := range served {
+		servedTypes[name] = true
+	}
+
+	var issues []Issue
+	resourceTypes := mappingValue(documentRoot(&root), "resource_types")
+	for _, section := range []string{"skip", "important"} {
+		for _, item := range sequenceItems(mappingValue(resourceTypes, section)) {
+			if !servedTypes[item.Value] {
+				issues = append(issues, Issue{
+					Line:     item.Line,
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("resource_types.%s: '%s'는 현재 클러스터가 제공하지 않는 리소스 타입입니다", section, item.Value),
+				})
+			}
+		}
+	}
+
+	sortIssues(issues)
+	return issues
+}
+
+type exclusionEntry struct {
+	rule ExclusionRule
+	line int
+}
+
+func validateExclusions(exclusions *yaml.Node) []Issue {
+	if exclusions == nil || exclusions.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var issues []Issue
+	var entries []exclusionEntry
+	for i := 0; i+1 < len(exclusions.Content); i += 2 {
+		category := exclusions.Content[i].Value
+		for _, item := range sequenceItems(exclusions.Content[i+1]) {
+			parts := strings.Split(item.Value, "/")
+			if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+				issues = append(issues, Issue{
+					Line:     item.Line,
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("exclusions.%s: '%s'는 <네임스페이스>/<Kind>/<이름> 형식이 아니므로 무시됩니다", category, item.Value),
+				})
+				continue
+			}
+
+			if err := validateExclusionParts(parts); err != nil {
+				issues = append(issues, Issue{
+					Line:     item.Line,
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("exclusions.%s: '%s'는 해석할 수 없으므로 무시됩니다: %v", category, item.Value, err),
+				})
+				continue
+			}
+			entries = append(entries, exclusionEntry{
+				rule: ExclusionRule{Namespace: parts[0], Kind: parts[1], Name: parts[2], Pattern: item.Value, Category: category},
+				line: item.Line,
+			})
+		}
+	}
+
+	return append(issues, findShadowedExclusions(entries)...)
+}
+
+func validateExclusionParts(parts []string) error {
+	for _, part := range parts {
+		if err := ValidatePattern(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findShadowedExclusions는 다른 규칙이 이미 모두 제외하는 리소스만 가리켜 효과가 없는 규칙을 찾는다
+func findShadowedExclusions(entries []exclusionEntry) []Issue {
+	var issues []Issue
+	for j, specific := range entries {
+		for i, general := range entries {
+			if i == j || !ruleCovers(general.rule, specific.rule) {
+				continue
+			}
+			// 서로 같은 범위인 규칙은 뒤에 있는 규칙만 보고한다
+			if ruleCovers(specific.rule, general.rule) && i > j {
+				continue
+			}
+			issues = append(issues, Issue{
+				Line:     specific.line,
+				Severity: SeverityWarning,
+				Message: fmt.Sprintf("exclusions.%s: '%s'는 %d번째 줄의 '%s' (exclusions.%s) 규칙에 포함되어 효과가 없습니다",
+					specific.rule.Category, specific.rule.Pattern, general.line, general.rule.Pattern, general.rule.Category),
+			})
+			break
+		}
+	}
+	return issues
+}
+
+func ruleCovers(general, specific ExclusionRule) bool {
+	return patternCovers(general.Namespace, specific.Namespace) &&
+		patternCovers(general.Kind, specific.Kind) &&
+		patternCovers(general.Name, specific.Name)
+}
+
+// patternCovers는 specific 패턴과 일치하는 모든 값이 general 패턴과도 일치하는지 보수적으로 판단한다.
+// 정규식과 '*'가 두 번 이상인 글로브는 포함 관계를 판단하지 않는다.
+func patternCovers(general, specific string) bool {
+	if general == "*" || general == specific {
+		return true
+	}
+	if strings.HasPrefix(general, RegexPrefix) || strings.HasPrefix(specific, RegexPrefix) {
+		return false
+	}
+	if !isPattern(specific) {
+		return matchPattern(general, specific)
+	}
+	if strings.Count(general, "*") != 1 || strings.ContainsAny(general, `?[\`) {
+		return false
+	}
+
+	// specific과 일치하는 값은 모두 첫 메타 문자 앞의 리터럴로 시작하고 마지막 메타 문자 뒤의 리터럴로 끝난다
+	generalPrefix, generalSuffix, _ := strings.Cut(general, "*")
+	specificPrefix := specific[:strings.IndexAny(specific, `*?[\`)]
+	specificSuffix := specific[strings.LastIndexAny(specific, `*?[]\`)+1:]
+	return strings.HasPrefix(specificPrefix, generalPrefix) && strings.HasSuffix(specificSuffix, generalSuffix)
+}
+
+// validateGlobs는 각 항목이 글로브 또는 re: 정규식으로 해석 가능한지 확인한다
+func validateGlobs(section string, items []*yaml.Node) []Issue {
+	var issues []Issue
+	for _, item := range items {
+		if err := ValidatePattern(item.Value); err != nil {
+			issues = append(issues, invalidPatternIssue(section, item, err))
+		}
+	}
+	return issues
+}
+
+func invalidPatternIssue(section string, item *yaml.Node, err error) Issue {
+	return Issue{Line: item.Line, Severity: SeverityError, Message: fmt.Sprintf("%s: '%s': %v", section, item.Value, err)}
+}
+
+func validatePatterns(patterns *yaml.Node) []Issue {
+	var issues []Issue
+	for _, item := range sequenceItems(mappingValue(patterns, "secret_patterns")) {
+		issues = append(issues, validateRegexp("patterns.secret_patterns", item)...)
+	}
+
+	if rancher := mappingValue(patterns, "rancher_managed"); rancher != nil && rancher.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(rancher.Content); i += 2 {
+			section := "patterns.rancher_managed." + rancher.Content[i].Value
+			for _, item := range sequenceItems(rancher.Content[i+1]) {
+				issues = append(issues, validateRegexp(section, item)...)
+			}
+		}
+	}
+
+	if pvc := mappingValue(patterns, "statefulset_pvc"); pvc != nil && pvc.Kind == yaml.ScalarNode && pvc.Value != "" {
+		issues = append(issues, validateRegexp("patterns.statefulset_pvc", pvc)...)
+	}
+	return issues
+}
+
+func validateRegexp(section string, item *yaml.Node) []Issue {
+	if _, err := regexp.Compile(item.Value); err != nil {
+		return []Issue{{Line: item.Line, Severity: SeverityError, Message: fmt.Sprintf("%s: 잘못된 정규식 '%s': %v", section, item.Value, err)}}
+	}
+	return nil
+}
+
+func validateManagers(managers *yaml.Node) []Issue {
+	var issues []Issue
+	names := make(map[string]int)
+	for i, item := range sequenceItems(managers) {
+		var rule ManagerRule
+		if err := item.Decode(&rule); err != nil {
+			// 타입 오류는 엄격한 디코딩에서 이미 보고한다
+			continue
+		}
+		if err := rule.validate(i); err != nil {
+			issues = append(issues, Issue{Line: item.Line, Severity: SeverityError, Message: err.Error()})
+			continue
+		}
+
+		if line, ok := names[rule.Name]; ok {
+			issues = append(issues, Issue{
+				Line:     item.Line,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("managers[%d]: '%s'는 %d번째 줄에 이미 있는 이름입니다", i, rule.Name, line),
+			})
+		} else {
+			names[rule.Name] = item.Line
+		}
+
+		section := fmt.Sprintf("managers[%d] (%s)", i, rule.Name)
+		for _, field := range []string{"labels", "annotations"} {
+			signatures := mappingValue(item, field)
+			if signatures == nil || signatures.Kind != yaml.MappingNode {
+				continue
+			}
+			issues = append(issues, validateGlobs(section+"."+field, signatures.Content)...)
+		}
+		issues = append(issues, validateGlobs(section+".field_managers", sequenceItems(mappingValue(item, "field_managers")))...)
+	}
+	return issues
+}
+
+func validateSelectors(selectors *yaml.Node) []Issue {
+	var issues []Issue
+	for _, scope := range []string{"resources", "namespaces"} {
+		rules := mappingValue(selectors, scope)
+		for _, field := range []string{"exclude_labels", "exclude_annotations", "include_labels", "include_annotations"} {
+			section := fmt.Sprintf("selectors.%s.%s", scope, field)
+			for _, item := range sequenceItems(mappingValue(rules, field)) {
+				if _, err := labels.Parse(item.Value); err != nil {
+					issues = append(issues, Issue{Line: item.Line, Severity: SeverityError, Message: fmt.Sprintf("%s: 잘못된 셀렉터 '%s': %v", section, item.Value, err)})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+var yamlErrorLine = regexp.MustCompile(`line (\d+): (.*)`)
+
+// yamlErrorIssues는 yaml.v3 오류 메시지("line N: ...")에서 줄 번호를 분리한다
+func yamlErrorIssues(err error) []Issue {
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	issues := make([]Issue, 0, len(messages))
+	for _, message := range messages {
+		issue := Issue{Severity: SeverityError, Message: message}
+		if m := yamlErrorLine.FindStringSubmatch(message); m != nil {
+			issue.Line, _ = strconv.Atoi(m[1])
+			issue.Message = m[2]
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+func documentRoot(root *yaml.Node) *yaml.Node {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		return root.Content[0]
+	}
+	return root
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func sequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+func sortIssues(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line < issues[j].Line
+	})
+}
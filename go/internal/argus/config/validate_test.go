@@ -0,0 +1,194 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Issue
+	}{
+		{
+			name: "올바른 설정 파일",
+			content: `
+exclusions:
+  default_patterns:
+    - "kube-system/*/*"
+    - "*/ConfigMap/kube-root-ca.crt"
+patterns:
+  secret_patterns:
+    - '^sh\.helm\.release\.v1\.'
+`,
+		},
+		{
+			name: "알 수 없는 키",
+			content: `
+performance:
+  max_concurrent: 20
+`,
+			want: []Issue{{Line: 3, Severity: SeverityError}},
+		},
+		{
+			name: "형식이 잘못된 제외 패턴",
+			content: `
+exclusions:
+  default_patterns:
+    - "kube-system/ConfigMap"
+    - "/Secret/token"
+`,
+			want: []Issue{{Line: 4, Severity: SeverityWarning}, {Line: 5, Severity: SeverityWarning}},
+		},
+		{
+			name: "해석할 수 없는 글로브와 정규식",
+			content: `
+exclusions:
+  default_patterns:
+    - "app/ConfigMap/a*b*c"
+    - "app/ConfigMap/data-[0-9"
+    - "app/Secret/re:(token"
+`,
+			want: []Issue{{Line: 5, Severity: SeverityWarning}, {Line: 6, Severity: SeverityWarning}},
+		},
+		{
+			name: "다른 규칙에 포함된 규칙",
+			content: `
+exclusions:
+  system:
+    - "kube-system/*/*"
+  default_patterns:
+    - "kube-system/ConfigMap/extension-*"
+    - "app/Secret/token-*"
+    - "app/Secret/token-abc"
+`,
+			want: []Issue{{Line: 6, Severity: SeverityWarning}, {Line: 8, Severity: SeverityWarning}},
+		},
+		{
+			name: "중복 규칙은 한 번만 보고",
+			content: `
+exclusions:
+  default_patterns:
+    - "app/Secret/token"
+    - "app/Secret/token"
+`,
+			want: []Issue{{Line: 5, Severity: SeverityWarning}},
+		},
+		{
+			name: "잘못된 정규식",
+			content: `
+patterns:
+  secret_patterns:
+    - '^ok-'
+    - '(unclosed'
+  rancher_managed:
+    ClusterRole:
+      - '[a-'
+`,
+			want: []Issue{{Line: 5, Severity: SeverityError}, {Line: 8, Severity: SeverityError}},
+		},
+		{
+			name: "잘못된 managers 규칙",
+			content: `
+managers:
+  - name: flux
+  - name: helm
+    labels:
+      app.kubernetes.io/managed-by: Helm
+  - name: helm
+    field_managers:
+      - "helm[ctl"
+`,
+			want: []Issue{{Line: 3, Severity: SeverityError}, {Line: 7, Severity: SeverityWarning}, {Line: 9, Severity: SeverityError}},
+		},
+		{
+			name: "잘못된 셀렉터",
+			content: `
+selectors:
+  namespaces:
+    include_labels:
+      - "team=payments"
+      - "team in (payments"
+`,
+			want: []Issue{{Line: 6, Severity: SeverityError}},
+		},
+		{
+			name:    "YAML 문법 오류",
+			content: "exclusions:\n\t- bad\n",
+			want:    []Issue{{Line: 2, Severity: SeverityError}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate([]byte(tt.content))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Validate() = %+v, want %d개", got, len(tt.want))
+			}
+			for i := range got {
+				if got[i].Line != tt.want[i].Line || got[i].Severity != tt.want[i].Severity {
+					t.Errorf("Validate()[%d] = %+v, want line %d %s", i, got[i], tt.want[i].Line, tt.want[i].Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_ShadowMessage(t *testing.T) {
+	content := `
+exclusions:
+  system:
+    - "kube-system/*/*"
+  default_patterns:
+    - "kube-system/ConfigMap/extension-*"
+`
+	issues := Validate([]byte(content))
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "4번째 줄의 'kube-system/*/*'") {
+		t.Errorf("포함하는 규칙의 위치가 메시지에 없습니다: %+v", issues)
+	}
+}
+
+func TestValidateResourceTypes(t *testing.T) {
+	content := `
+resource_types:
+  skip:
+    - events
+    - policyreports.wgpolicyk8s.io
+  important:
+    - deployments.apps
+`
+	served := []string{"deployments", "deployments.apps", "events", "events.events.k8s.io"}
+
+	issues := ValidateResourceTypes([]byte(content), served)
+	if len(issues) != 1 || issues[0].Line != 5 || issues[0].Severity != SeverityWarning {
+		t.Errorf("ValidateResourceTypes() = %+v, want line 5 경고 1개", issues)
+	}
+}
+
+func TestPatternCovers(t *testing.T) {
+	tests := []struct {
+		general  string
+		specific string
+		want     bool
+	}{
+		{"*", "anything-*", true},
+		{"token-*", "token-abc", true},
+		{"token-*", "token-a*", true},
+		{"token-*", "tok*", false},
+		{"*-config", "app-*-config", true},
+		{"app-*", "*-config", false},
+		{"team-*", "team-?-[0-9]", true},
+		{"team-*-prod", "team-a-prod", true},
+		{"team-*-prod", "team-*-prod-*", false},
+		{"re:^team-", "team-a", false},
+		{"app-*", "*", false},
+		{"token-abc", "token-*", false},
+	}
+
+	for _, tt := range tests {
+		if got := patternCovers(tt.general, tt.specific); got != tt.want {
+			t.Errorf("patternCovers(%q, %q) = %v, want %v", tt.general, tt.specific, got, tt.want)
+		}
+	}
+}
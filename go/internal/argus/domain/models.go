@@ -1,21 +1,148 @@
 package domain
 
-import "gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+import (
+	"strings"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+)
 
 type ResourceIdentifier struct {
-	APIVersion string
-	Kind       string
-	Name       string
-	Namespace  string
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
 }
 
 type KubernetesResource struct {
-	Identifier      ResourceIdentifier
-	CreatedAt       string
-	Labels          map[string]string
-	Annotations     map[string]string
-	OwnerReferences []interface{}
-	Config          *config.Config
+	Identifier      ResourceIdentifier  `json:"identifier"`
+	CreatedAt       string              `json:"createdAt"`
+	Labels          map[string]string   `json:"labels"`
+	Annotations     map[string]string   `json:"annotations"`
+	OwnerReferences []interface{}       `json:"ownerReferences,omitempty"`
+	ManagedFields   []ManagedFieldEntry `json:"managedFields,omitempty"`
+	// Manager는 분석 결과 이 리소스를 관리하는 도구 이름이다 (수동 리소스는 빈 값)
+	Manager string `json:"manager,omitempty"`
+	// DecidedBy는 분석 결과를 결정한 규칙이다 (어떤 규칙에도 해당하지 않은 수동 리소스는 nil)
+	DecidedBy *DecisionRule  `json:"decidedBy,omitempty"`
+	Config    *config.Config `json:"-"`
+	// Object는 조회한 원본 객체이며 매니페스트 내보내기에 사용한다. 분석 후에는 수동 리소스에만 남는다.
+	Object map[string]interface{} `json:"-"`
+}
+
+// DecisionRule은 리소스의 분류를 결정한 규칙이다.
+// Section은 rules.yaml 안의 위치(예: "exclusions.others", "patterns.statefulset_pvc"), Pattern은 일치한 패턴이나 값이다.
+type DecisionRule struct {
+	Section string `json:"section"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+func (d DecisionRule) String() string {
+	if d.Pattern == "" {
+		return d.Section
+	}
+	return d.Section + ": " + d.Pattern
+}
+
+// Classification은 분석기가 리소스 하나를 분류한 결과이다
+type Classification string
+
+const (
+	ClassificationChild        Classification = "child"
+	ClassificationExcluded     Classification = "excluded"
+	ClassificationOrphaned     Classification = "orphaned"
+	ClassificationArgoCD       Classification = "argocd"
+	ClassificationOtherManaged Classification = "other_managed"
+	ClassificationManual       Classification = "manual"
+)
+
+var classificationLabels = map[Classification]string{
+	ClassificationChild:        "하위 리소스 (ownerReferences가 있어 분석하지 않음)",
+	ClassificationExcluded:     "기본 리소스 (제외됨)",
+	ClassificationOrphaned:     "고아 GitOps 리소스",
+	ClassificationArgoCD:       "ArgoCD 관리",
+	ClassificationOtherManaged: "기타 도구 관리",
+	ClassificationManual:       "수동 생성",
+}
+
+func (c Classification) Label() string {
+	if label, ok := classificationLabels[c]; ok {
+		return label
+	}
+	return string(c)
+}
+
+// ManagerArgoCD는 ManagerCounts에서 ArgoCD 관리 리소스를 나타내는 이름이다
+const ManagerArgoCD = "ArgoCD"
+
+// ClusterScope는 클러스터 범위 리소스의 분석 결과 키이다.
+// 클러스터 범위 리소스의 Identifier.Namespace에도 이 값이 들어가므로 제외 규칙에서 "(cluster)/ClusterRole/system:*"처럼 지정할 수 있다.
+const ClusterScope = "(cluster)"
+
+// ScopeLabel은 리포트와 판정 메시지에 표시할 범위 이름으로, 클러스터 범위는 네임스페이스와 구분되게 표시한다
+func ScopeLabel(namespace string) string {
+	if namespace == ClusterScope {
+		return "클러스터 범위"
+	}
+	return namespace
+}
+
+// ManagedFieldEntry는 metadata.managedFields 항목에서 필드 매니저 정보만 추린 것이다
+type ManagedFieldEntry struct {
+	Manager     string `json:"manager"`
+	Operation   string `json:"operation"`
+	Time        string `json:"time,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
+
+// FieldManagers는 status 등 하위 리소스를 제외한 필드 매니저 이름 목록을 반환한다
+func (r *KubernetesResource) FieldManagers() []string {
+	var managers []string
+	for _, entry := range r.objectFieldEntries() {
+		managers = append(managers, entry.Manager)
+	}
+	return managers
+}
+
+// CreatedBy는 가장 먼저 기록된 필드 매니저를 생성 주체로 추정해 반환한다
+func (r *KubernetesResource) CreatedBy() string {
+	entries := r.objectFieldEntries()
+	if len(entries) == 0 {
+		return ""
+	}
+	earliest := entries[0]
+	for _, entry := range entries[1:] {
+		if entry.Time != "" && (earliest.Time == "" || entry.Time < earliest.Time) {
+			earliest = entry
+		}
+	}
+	return earliest.Manager
+}
+
+// LastModifiedBy는 가장 최근에 기록된 필드 매니저를 반환한다
+func (r *KubernetesResource) LastModifiedBy() string {
+	entries := r.objectFieldEntries()
+	if len(entries) == 0 {
+		return ""
+	}
+	latest := entries[0]
+	for _, entry := range entries[1:] {
+		if entry.Time > latest.Time {
+			latest = entry
+		}
+	}
+	return latest.Manager
+}
+
+// status 갱신은 컨트롤러가 주기적으로 기록하므로 생성/수정 주체 판단에서 제외한다
+func (r *KubernetesResource) objectFieldEntries() []ManagedFieldEntry {
+	var entries []ManagedFieldEntry
+	for _, entry := range r.ManagedFields {
+		if entry.Subresource == "" && entry.Manager != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
 }
 
 func (r *KubernetesResource) IsRootResource() bool {
@@ -23,35 +150,124 @@ func (r *KubernetesResource) IsRootResource() bool {
 }
 
 func (r *KubernetesResource) IsArgoCDManaged() bool {
+	return r.ArgoCDManagedRule() != nil
+}
+
+// ArgoCDManagedRule은 리소스를 ArgoCD 관리로 판단한 규칙을 반환하며, ArgoCD 관리가 아니면 nil이다
+func (r *KubernetesResource) ArgoCDManagedRule() *DecisionRule {
+	if rule := r.ArgoCDTrackingRule(); rule != nil {
+		return rule
+	}
+	return r.ArgoCDSharedLabelRule()
+}
+
+// ArgoCDTrackingRule은 ArgoCD만 기록하는 라벨/어노테이션/필드 매니저로 ArgoCD 관리를 판단한 규칙을 반환한다
+func (r *KubernetesResource) ArgoCDTrackingRule() *DecisionRule {
 	if r.Config != nil {
 		for _, label := range r.Config.GetManagedLabels() {
-			if _, ok := r.Labels[label]; ok {
-				return true
+			if _, ok := r.Labels[label]; ok && strings.HasPrefix(label, argoCDKeyPrefix) {
+				return &DecisionRule{Section: "argocd.managed_labels", Pattern: label}
 			}
 		}
 		for _, ann := range r.Config.GetSyncAnnotations() {
 			if _, ok := r.Annotations[ann]; ok {
-				return true
+				return &DecisionRule{Section: "argocd.sync_annotations", Pattern: ann}
 			}
 		}
+		if pattern := r.Config.MatchArgoCDFieldManager(r.FieldManagers()); pattern != "" {
+			return &DecisionRule{Section: "argocd.field_managers", Pattern: pattern}
+		}
+	}
+	// ArgoCD가 항상 기록하는 추적 정보는 설정과 관계없이 인식한다
+	if _, ok := r.Labels[ArgoCDInstanceLabel]; ok {
+		return &DecisionRule{Section: "argocd (기본 라벨)", Pattern: ArgoCDInstanceLabel}
+	}
+	if _, ok := r.Annotations[ArgoCDTrackingIDAnnotation]; ok {
+		return &DecisionRule{Section: "argocd (기본 어노테이션)", Pattern: ArgoCDTrackingIDAnnotation}
+	}
+	return nil
+}
+
+// ArgoCDSharedLabelRule은 app.kubernetes.io/instance처럼 Helm 등 다른 도구도 쓰는 managed_labels로 판단한 규칙을 반환한다.
+// 이런 라벨만으로는 ArgoCD 관리라고 확신할 수 없으므로 분석기는 managers 규칙보다 나중에 확인한다.
+func (r *KubernetesResource) ArgoCDSharedLabelRule() *DecisionRule {
+	if r.Config == nil {
+		return nil
 	}
-	if _, ok := r.Labels["argocd.argoproj.io/instance"]; ok {
-		return true
+	for _, label := range r.Config.GetManagedLabels() {
+		if _, ok := r.Labels[label]; ok && !strings.HasPrefix(label, argoCDKeyPrefix) {
+			return &DecisionRule{Section: "argocd.managed_labels", Pattern: label}
+		}
 	}
-	if _, ok := r.Annotations["argocd.argoproj.io/tracking-id"]; ok {
-		return true
+	return nil
+}
+
+// argoCDKeyPrefix는 ArgoCD만 기록하는 라벨/어노테이션 키의 접두사이다
+const argoCDKeyPrefix = "argocd.argoproj.io/"
+
+const (
+	ArgoCDTrackingIDAnnotation = "argocd.argoproj.io/tracking-id"
+	ArgoCDInstanceLabel        = "argocd.argoproj.io/instance"
+)
+
+// ArgoCDApplication은 tracking-id 어노테이션 또는 instance 라벨이 가리키는 Application 이름을 반환한다.
+// 다른 네임스페이스의 Application은 ArgoCD 규칙에 따라 "<네임스페이스>_<이름>" 형식이다.
+func (r *KubernetesResource) ArgoCDApplication() string {
+	// tracking-id 형식: <application>:<group>/<kind>:<namespace>/<name>
+	if trackingID := r.Annotations[ArgoCDTrackingIDAnnotation]; trackingID != "" {
+		if app, _, found := strings.Cut(trackingID, ":"); found && app != "" {
+			return app
+		}
 	}
-	return false
+	return r.Labels[ArgoCDInstanceLabel]
+}
+
+type ResourceTypeFailure struct {
+	ResourceType string `json:"resourceType"`
+	Reason       string `json:"reason"`
+	Message      string `json:"message"`
+}
+
+const (
+	BaselineExpired = "expired"
+	BaselineStale   = "stale"
+)
+
+// BaselineFinding은 만료되었거나 더 이상 수동 리소스로 발견되지 않아 재검토가 필요한 베이스라인 항목이다
+type BaselineFinding struct {
+	Identifier ResourceIdentifier `json:"identifier"`
+	Status     string             `json:"status"`
+	Reason     string             `json:"reason"`
+	Owner      string             `json:"owner"`
+	Expires    string             `json:"expires,omitempty"`
 }
 
 type AnalysisResult struct {
-	TotalResources     int
-	RootResources      int
-	ArgoCDManaged      int
-	ManualResources    int
-	ExcludedDefaults   int
-	ManualResourceList []KubernetesResource
-	ArgoCDResourceList []KubernetesResource
+	TotalResources      int                   `json:"totalResources"`
+	RootResources       int                   `json:"rootResources"`
+	ArgoCDManaged       int                   `json:"argoCDManaged"`
+	ManualResources     int                   `json:"manualResources"`
+	ExcludedDefaults    int                   `json:"excludedDefaults"`
+	ManualResourceList  []KubernetesResource  `json:"manualResourceList"`
+	ArgoCDResourceList  []KubernetesResource  `json:"argoCDResourceList"`
+	FailedResourceTypes []ResourceTypeFailure `json:"failedResourceTypes,omitempty"`
+	// OrphanedGitOps는 ArgoCD 추적 정보가 있지만 가리키는 Application이 없는 리소스 수이다
+	OrphanedGitOps       int                  `json:"orphanedGitOps"`
+	OrphanedResourceList []KubernetesResource `json:"orphanedResourceList,omitempty"`
+	// OtherManaged는 rules.yaml의 managers 규칙으로 식별된 ArgoCD 외 도구(Helm, Flux 등)가 관리하는 리소스 수이다
+	OtherManaged             int                  `json:"otherManaged"`
+	OtherManagedResourceList []KubernetesResource `json:"otherManagedResourceList,omitempty"`
+	// ManagerCounts는 관리 도구별 리소스 수이다 (ArgoCD 포함, 수동 리소스 제외)
+	ManagerCounts      map[string]int    `json:"managerCounts,omitempty"`
+	BaselineSuppressed int               `json:"baselineSuppressed,omitempty"`
+	BaselineFindings   []BaselineFinding `json:"baselineFindings,omitempty"`
+	// ExcludedResourceList는 기본 리소스로 제외된 리소스이며, 제외한 규칙은 각 리소스의 DecidedBy에 있다
+	ExcludedResourceList []KubernetesResource `json:"excludedResourceList,omitempty"`
+}
+
+// IsIncomplete는 일부 리소스 타입을 조회하지 못해 결과를 신뢰할 수 없는지 여부를 반환한다
+func (r AnalysisResult) IsIncomplete() bool {
+	return len(r.FailedResourceTypes) > 0
 }
 
 type NamespaceAnalysis struct {
@@ -59,3 +275,58 @@ type NamespaceAnalysis struct {
 	Result    AnalysisResult
 	Error     error
 }
+
+// NamespaceDelta는 이전 스캔 대비 네임스페이스 하나의 수동 리소스 변화이다
+type NamespaceDelta struct {
+	Namespace      string `json:"namespace"`
+	PreviousManual int    `json:"previousManual"`
+	CurrentManual  int    `json:"currentManual"`
+	// NewNamespace는 이전 스캔에 없던 네임스페이스임을 나타낸다
+	NewNamespace bool                 `json:"newNamespace,omitempty"`
+	Appeared     []ResourceIdentifier `json:"appeared,omitempty"`
+	// Adopted는 ArgoCD 또는 managers 규칙의 도구가 관리하게 된 리소스이다
+	Adopted []ResourceIdentifier `json:"adopted,omitempty"`
+	// Resolved는 삭제되었거나 제외 규칙/베이스라인에 의해 더 이상 보고되지 않는 리소스이다
+	Resolved []ResourceIdentifier `json:"resolved,omitempty"`
+	// Incomplete는 이번 결과가 불완전한 스캔이라 Resolved를 판단하지 않았음을 나타낸다
+	Incomplete bool `json:"incomplete,omitempty"`
+}
+
+func (d NamespaceDelta) Change() int {
+	return d.CurrentManual - d.PreviousManual
+}
+
+func (d NamespaceDelta) HasChanges() bool {
+	return d.NewNamespace || d.Change() != 0 || len(d.Appeared) > 0 || len(d.Adopted) > 0 || len(d.Resolved) > 0
+}
+
+// ScanComparison은 이전 실행 결과와의 비교 결과이다
+type ScanComparison struct {
+	PreviousReport    string           `json:"previousReport"`
+	PreviousStartTime time.Time        `json:"previousStartTime"`
+	PreviousManual    int              `json:"previousManual"`
+	CurrentManual     int              `json:"currentManual"`
+	Deltas            []NamespaceDelta `json:"deltas"`
+	// MissingNamespaces는 이전 스캔에는 있었지만 이번에 검사하지 않은 네임스페이스이다
+	MissingNamespaces []string `json:"missingNamespaces,omitempty"`
+}
+
+func (c ScanComparison) Change() int {
+	return c.CurrentManual - c.PreviousManual
+}
+
+// RunInfo는 리포트에 표시할 실행 정보이다
+type RunInfo struct {
+	Context   string
+	Cluster   string
+	StartTime time.Time
+	// Partial은 스캔이 중단되어 일부 네임스페이스만 분석되었음을 나타낸다
+	Partial           bool
+	SkippedNamespaces []string
+	// DiscoveryFailures는 discovery에 실패해 스캔 대상에서 빠진 API 그룹이며, 모든 결과의 FailedResourceTypes에도 포함된다
+	DiscoveryFailures []ResourceTypeFailure
+	// BaselineFile은 적용된 베이스라인 파일 경로이며 비어 있으면 베이스라인을 사용하지 않은 것이다
+	BaselineFile string
+	// Comparison은 --compare로 지정한 이전 실행과의 비교 결과이며 없으면 nil이다
+	Comparison *ScanComparison
+}
@@ -51,6 +51,7 @@ func TestKubernetesResource_IsArgoCDManaged(t *testing.T) {
 		name        string
 		labels      map[string]string
 		annotations map[string]string
+		fields      []ManagedFieldEntry
 		config      *config.Config
 		want        bool
 	}{
@@ -102,6 +103,18 @@ func TestKubernetesResource_IsArgoCDManaged(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name:        "Config의 ArgoCD 필드 매니저가 있는 경우",
+			labels:      map[string]string{"app": "test"},
+			annotations: map[string]string{},
+			fields:      []ManagedFieldEntry{{Manager: "argocd-controller", Operation: "Apply"}},
+			config: &config.Config{
+				ArgoCD: config.ArgoCDConfig{
+					FieldManagers: []string{"argocd-controller"},
+				},
+			},
+			want: true,
+		},
 		{
 			name:        "관리되지 않는 리소스",
 			labels:      map[string]string{"app": "test"},
@@ -125,9 +138,10 @@ func TestKubernetesResource_IsArgoCDManaged(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &KubernetesResource{
-				Labels:      tt.labels,
-				Annotations: tt.annotations,
-				Config:      tt.config,
+				Labels:        tt.labels,
+				Annotations:   tt.annotations,
+				ManagedFields: tt.fields,
+				Config:        tt.config,
 			}
 			if got := r.IsArgoCDManaged(); got != tt.want {
 				t.Errorf("IsArgoCDManaged() = %v, want %v", got, tt.want)
@@ -136,6 +150,94 @@ func TestKubernetesResource_IsArgoCDManaged(t *testing.T) {
 	}
 }
 
+func TestKubernetesResource_ArgoCDApplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		want        string
+	}{
+		{
+			name:        "tracking-id 어노테이션",
+			annotations: map[string]string{ArgoCDTrackingIDAnnotation: "my-app:apps/Deployment:default/web"},
+			want:        "my-app",
+		},
+		{
+			name:        "다른 네임스페이스의 Application",
+			annotations: map[string]string{ArgoCDTrackingIDAnnotation: "team-a_my-app:/ConfigMap:team-a/settings"},
+			want:        "team-a_my-app",
+		},
+		{
+			name:        "tracking-id가 instance 라벨보다 우선",
+			labels:      map[string]string{ArgoCDInstanceLabel: "label-app"},
+			annotations: map[string]string{ArgoCDTrackingIDAnnotation: "annotation-app:/Secret:default/s"},
+			want:        "annotation-app",
+		},
+		{
+			name:   "instance 라벨",
+			labels: map[string]string{ArgoCDInstanceLabel: "label-app"},
+			want:   "label-app",
+		},
+		{
+			name:   "추적 정보 없음",
+			labels: map[string]string{"app.kubernetes.io/instance": "helm-release"},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &KubernetesResource{Labels: tt.labels, Annotations: tt.annotations}
+			if got := r.ArgoCDApplication(); got != tt.want {
+				t.Errorf("ArgoCDApplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKubernetesResource_FieldManagerAttribution(t *testing.T) {
+	tests := []struct {
+		name             string
+		fields           []ManagedFieldEntry
+		wantCreatedBy    string
+		wantLastModified string
+	}{
+		{
+			name: "생성 후 수정",
+			fields: []ManagedFieldEntry{
+				{Manager: "kubectl-edit", Operation: "Update", Time: "2024-03-01T00:00:00Z"},
+				{Manager: "kubectl-create", Operation: "Update", Time: "2024-01-01T00:00:00Z"},
+			},
+			wantCreatedBy:    "kubectl-create",
+			wantLastModified: "kubectl-edit",
+		},
+		{
+			name: "status 하위 리소스는 무시",
+			fields: []ManagedFieldEntry{
+				{Manager: "kubectl-client-side-apply", Operation: "Update", Time: "2024-01-01T00:00:00Z"},
+				{Manager: "kube-controller-manager", Operation: "Update", Time: "2024-05-01T00:00:00Z", Subresource: "status"},
+			},
+			wantCreatedBy:    "kubectl-client-side-apply",
+			wantLastModified: "kubectl-client-side-apply",
+		},
+		{
+			name: "managedFields 없음",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &KubernetesResource{ManagedFields: tt.fields}
+			if got := r.CreatedBy(); got != tt.wantCreatedBy {
+				t.Errorf("CreatedBy() = %v, want %v", got, tt.wantCreatedBy)
+			}
+			if got := r.LastModifiedBy(); got != tt.wantLastModified {
+				t.Errorf("LastModifiedBy() = %v, want %v", got, tt.wantLastModified)
+			}
+		})
+	}
+}
+
 func TestResourceIdentifier(t *testing.T) {
 	// ResourceIdentifier 구조체의 필드가 올바르게 설정되는지 테스트
 	identifier := ResourceIdentifier{
@@ -0,0 +1,153 @@
+package gate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+const (
+	ExitCodePass = 0
+	ExitCodeFail = 2
+
+	// Unlimited는 해당 임계값을 검사하지 않음을 의미한다
+	Unlimited = -1
+)
+
+type Thresholds struct {
+	MaxTotal int
+	// MaxPerNamespace는 클러스터 범위 결과에는 적용하지 않으며, 클러스터 범위는 MaxClusterScope로 따로 검사한다
+	MaxPerNamespace int
+	MaxClusterScope int
+	FailKinds       []string
+	// AllowIncomplete이면 일부 리소스 타입을 조회하지 못한 네임스페이스가 있어도 실패로 보지 않는다
+	AllowIncomplete bool
+}
+
+type Verdict struct {
+	Passed      bool
+	TotalManual int
+	Violations  []string
+}
+
+func NewThresholds(maxTotal, maxPerNamespace int, failKinds []string) Thresholds {
+	// 아무 조건도 지정하지 않으면 수동 리소스가 하나라도 있으면 실패
+	if maxTotal < 0 && maxPerNamespace < 0 && len(failKinds) == 0 {
+		maxTotal = 0
+	}
+
+	return Thresholds{
+		MaxTotal:        maxTotal,
+		MaxPerNamespace: maxPerNamespace,
+		MaxClusterScope: Unlimited,
+		FailKinds:       failKinds,
+	}
+}
+
+func Evaluate(results map[string]domain.AnalysisResult, thresholds Thresholds) Verdict {
+	verdict := Verdict{Passed: true}
+
+	var sortedNamespaces []string
+	for ns := range results {
+		sortedNamespaces = append(sortedNamespaces, ns)
+	}
+	sort.Strings(sortedNamespaces)
+
+	for _, ns := range sortedNamespaces {
+		verdict.TotalManual += results[ns].ManualResources
+	}
+
+	// 조회하지 못한 리소스 타입에 수동 리소스가 있을 수 있으므로 기본적으로 불완전한 스캔은 통과시키지 않는다
+	if !thresholds.AllowIncomplete {
+		for _, ns := range sortedNamespaces {
+			if failures := results[ns].FailedResourceTypes; len(failures) > 0 {
+				verdict.addViolation("%s: 불완전한 스캔 (리소스 타입 %d개 조회 실패)", domain.ScopeLabel(ns), len(failures))
+			}
+		}
+	}
+
+	if thresholds.MaxTotal >= 0 && verdict.TotalManual > thresholds.MaxTotal {
+		verdict.addViolation("전체 수동 리소스 %d개 > 허용 %d개", verdict.TotalManual, thresholds.MaxTotal)
+	}
+
+	if thresholds.MaxPerNamespace >= 0 {
+		for _, ns := range sortedNamespaces {
+			if ns == domain.ClusterScope {
+				continue
+			}
+			if count := results[ns].ManualResources; count > thresholds.MaxPerNamespace {
+				verdict.addViolation("%s: 수동 리소스 %d개 > 허용 %d개", ns, count, thresholds.MaxPerNamespace)
+			}
+		}
+	}
+
+	if result, ok := results[domain.ClusterScope]; ok && thresholds.MaxClusterScope >= 0 && result.ManualResources > thresholds.MaxClusterScope {
+		verdict.addViolation("%s: 수동 리소스 %d개 > 허용 %d개", domain.ScopeLabel(domain.ClusterScope), result.ManualResources, thresholds.MaxClusterScope)
+	}
+
+	if len(thresholds.FailKinds) > 0 {
+		kindCounts := countManualKinds(results, thresholds.FailKinds)
+		for _, kind := range thresholds.FailKinds {
+			if count := kindCounts[strings.ToLower(kind)]; count > 0 {
+				verdict.addViolation("금지된 수동 %s %d개", kind, count)
+			}
+		}
+	}
+
+	return verdict
+}
+
+func countManualKinds(results map[string]domain.AnalysisResult, kinds []string) map[string]int {
+	targets := make(map[string]bool)
+	for _, kind := range kinds {
+		targets[strings.ToLower(kind)] = true
+	}
+
+	counts := make(map[string]int)
+	for _, result := range results {
+		for _, resource := range result.ManualResourceList {
+			kind := strings.ToLower(resource.Identifier.Kind)
+			if targets[kind] {
+				counts[kind]++
+			}
+		}
+	}
+	return counts
+}
+
+func (v *Verdict) addViolation(format string, args ...interface{}) {
+	v.Passed = false
+	v.Violations = append(v.Violations, fmt.Sprintf(format, args...))
+}
+
+func (v Verdict) ExitCode() int {
+	if v.Passed {
+		return ExitCodePass
+	}
+	return ExitCodeFail
+}
+
+func (v Verdict) Summary() string {
+	if v.Passed {
+		return fmt.Sprintf("ARGUS GATE PASS: 수동 리소스 %d개 (임계값 이내)", v.TotalManual)
+	}
+
+	const maxShown = 5
+	violations := v.Violations
+	if len(violations) > maxShown {
+		violations = append(violations[:maxShown:maxShown], fmt.Sprintf("외 %d건", len(v.Violations)-maxShown))
+	}
+	return fmt.Sprintf("ARGUS GATE FAIL: %s", strings.Join(violations, "; "))
+}
+
+func ParseKinds(value string) []string {
+	var kinds []string
+	for _, kind := range strings.Split(value, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
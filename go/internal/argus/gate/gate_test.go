@@ -0,0 +1,197 @@
+package gate
+
+import (
+	"strings"
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func manualResource(kind, name string) domain.KubernetesResource {
+	return domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{Kind: kind, Name: name},
+	}
+}
+
+func TestNewThresholds(t *testing.T) {
+	thresholds := NewThresholds(Unlimited, Unlimited, nil)
+	if thresholds.MaxTotal != 0 {
+		t.Errorf("조건 미지정 시 MaxTotal = %v, want 0", thresholds.MaxTotal)
+	}
+
+	thresholds = NewThresholds(Unlimited, 3, nil)
+	if thresholds.MaxTotal != Unlimited {
+		t.Errorf("네임스페이스 임계값 지정 시 MaxTotal = %v, want %v", thresholds.MaxTotal, Unlimited)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app-a": {
+			ManualResources: 2,
+			ManualResourceList: []domain.KubernetesResource{
+				manualResource("Secret", "bootstrap"),
+				manualResource("ConfigMap", "settings"),
+			},
+		},
+		"app-b": {
+			ManualResources: 1,
+			ManualResourceList: []domain.KubernetesResource{
+				manualResource("Deployment", "hotfix"),
+			},
+		},
+		"app-c": {},
+	}
+
+	tests := []struct {
+		name           string
+		thresholds     Thresholds
+		wantPassed     bool
+		wantViolations int
+	}{
+		{
+			name:           "기본값: 수동 리소스가 있으면 실패",
+			thresholds:     NewThresholds(Unlimited, Unlimited, nil),
+			wantPassed:     false,
+			wantViolations: 1,
+		},
+		{
+			name:       "전체 임계값 이내",
+			thresholds: NewThresholds(3, Unlimited, nil),
+			wantPassed: true,
+		},
+		{
+			name:           "네임스페이스별 임계값 초과",
+			thresholds:     NewThresholds(Unlimited, 1, nil),
+			wantPassed:     false,
+			wantViolations: 1,
+		},
+		{
+			name:           "금지 Kind 포함",
+			thresholds:     NewThresholds(Unlimited, Unlimited, []string{"secret", "Deployment"}),
+			wantPassed:     false,
+			wantViolations: 2,
+		},
+		{
+			name:       "금지 Kind 미포함",
+			thresholds: NewThresholds(Unlimited, Unlimited, []string{"ClusterRole"}),
+			wantPassed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict := Evaluate(results, tt.thresholds)
+
+			if verdict.Passed != tt.wantPassed {
+				t.Errorf("Passed = %v, want %v (%v)", verdict.Passed, tt.wantPassed, verdict.Violations)
+			}
+			if len(verdict.Violations) != tt.wantViolations {
+				t.Errorf("Violations 수 = %v, want %v", len(verdict.Violations), tt.wantViolations)
+			}
+			if verdict.TotalManual != 3 {
+				t.Errorf("TotalManual = %v, want 3", verdict.TotalManual)
+			}
+		})
+	}
+}
+
+func TestVerdict_ExitCodeAndSummary(t *testing.T) {
+	passed := Verdict{Passed: true, TotalManual: 0}
+	if passed.ExitCode() != ExitCodePass {
+		t.Errorf("ExitCode() = %v, want %v", passed.ExitCode(), ExitCodePass)
+	}
+	if !strings.HasPrefix(passed.Summary(), "ARGUS GATE PASS") {
+		t.Errorf("Summary() = %v", passed.Summary())
+	}
+
+	failed := Verdict{Passed: false, Violations: []string{"a", "b", "c", "d", "e", "f", "g"}}
+	if failed.ExitCode() != ExitCodeFail {
+		t.Errorf("ExitCode() = %v, want %v", failed.ExitCode(), ExitCodeFail)
+	}
+	summary := failed.Summary()
+	if !strings.HasPrefix(summary, "ARGUS GATE FAIL") || !strings.Contains(summary, "외 2건") {
+		t.Errorf("Summary() = %v", summary)
+	}
+	if strings.Contains(summary, "; f") {
+		t.Error("최대 표시 개수를 초과한 위반 항목이 포함되었습니다")
+	}
+}
+
+func TestParseKinds(t *testing.T) {
+	got := ParseKinds(" Secret, ,Deployment ")
+	if len(got) != 2 || got[0] != "Secret" || got[1] != "Deployment" {
+		t.Errorf("ParseKinds() = %v", got)
+	}
+}
+
+func TestEvaluate_Incomplete(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app-a": {
+			FailedResourceTypes: []domain.ResourceTypeFailure{{ResourceType: "secrets", Reason: "forbidden"}},
+		},
+		"app-b": {},
+	}
+
+	verdict := Evaluate(results, NewThresholds(Unlimited, Unlimited, nil))
+	if verdict.Passed {
+		t.Fatal("불완전한 스캔이 통과했습니다")
+	}
+	if len(verdict.Violations) != 1 || !strings.Contains(verdict.Violations[0], "app-a") {
+		t.Errorf("Violations = %v, want app-a 불완전한 스캔", verdict.Violations)
+	}
+
+	thresholds := NewThresholds(Unlimited, Unlimited, nil)
+	thresholds.AllowIncomplete = true
+	if verdict := Evaluate(results, thresholds); !verdict.Passed {
+		t.Errorf("AllowIncomplete인데 실패했습니다: %v", verdict.Violations)
+	}
+}
+
+func TestEvaluate_ClusterScope(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app-a": {ManualResources: 1},
+		domain.ClusterScope: {
+			ManualResources:     3,
+			FailedResourceTypes: []domain.ResourceTypeFailure{{ResourceType: "clusterroles", Reason: "forbidden"}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		maxPerNS       int
+		maxCluster     int
+		wantViolations []string
+	}{
+		{
+			name:           "네임스페이스별 임계값은 클러스터 범위에 적용하지 않음",
+			maxPerNS:       1,
+			maxCluster:     Unlimited,
+			wantViolations: []string{"클러스터 범위: 불완전한 스캔 (리소스 타입 1개 조회 실패)"},
+		},
+		{
+			name:       "클러스터 범위 임계값 초과",
+			maxPerNS:   1,
+			maxCluster: 2,
+			wantViolations: []string{
+				"클러스터 범위: 불완전한 스캔 (리소스 타입 1개 조회 실패)",
+				"클러스터 범위: 수동 리소스 3개 > 허용 2개",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thresholds := NewThresholds(Unlimited, tt.maxPerNS, nil)
+			thresholds.MaxClusterScope = tt.maxCluster
+			verdict := Evaluate(results, thresholds)
+
+			if strings.Join(verdict.Violations, "\n") != strings.Join(tt.wantViolations, "\n") {
+				t.Errorf("Violations = %v, want %v", verdict.Violations, tt.wantViolations)
+			}
+			if verdict.TotalManual != 4 {
+				t.Errorf("TotalManual = %v, want 4", verdict.TotalManual)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/reporter"
+)
+
+// Snapshot은 이전 실행의 JSON 리포트이다
+type Snapshot struct {
+	Path   string
+	Report reporter.JSONReport
+}
+
+// ResolveReportPath는 디렉토리가 주어지면 그 안에서 가장 최근에 수정된 JSON 리포트를 찾는다
+func ResolveReportPath(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("이전 리포트를 찾을 수 없습니다: %w", err)
+	}
+	if !info.IsDir() {
+		return path, nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return "", fmt.Errorf("이전 리포트 검색 실패: %w", err)
+	}
+
+	latest := ""
+	var latestInfo os.FileInfo
+	for _, match := range matches {
+		matchInfo, err := os.Stat(match)
+		if err != nil || matchInfo.IsDir() {
+			continue
+		}
+		if latestInfo == nil || matchInfo.ModTime().After(latestInfo.ModTime()) {
+			latest, latestInfo = match, matchInfo
+		}
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("%s에 JSON 리포트가 없습니다 (-o json으로 생성된 리포트가 필요합니다)", path)
+	}
+	return latest, nil
+}
+
+func Load(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("이전 리포트 읽기 실패: %w", err)
+	}
+
+	var report reporter.JSONReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("이전 리포트 파싱 실패 (%s): %w", path, err)
+	}
+	if report.Results == nil {
+		return nil, fmt.Errorf("이전 리포트에 results가 없습니다: %s", path)
+	}
+
+	return &Snapshot{Path: path, Report: report}, nil
+}
+
+// Compare는 이번 결과를 이전 스냅샷과 비교한다.
+// 이전에 수동 리소스였던 항목이 이번에 ArgoCD 관리 목록에 있으면 편입(Adopted), 그 외에는 해소(Resolved)로 분류한다.
+func (s *Snapshot) Compare(current map[string]domain.AnalysisResult) domain.ScanComparison {
+	comparison := domain.ScanComparison{
+		PreviousReport:    s.Path,
+		PreviousStartTime: s.Report.Metadata.StartTime,
+	}
+
+	for ns, result := range current {
+		comparison.CurrentManual += result.ManualResources
+
+		previous, existed := s.Report.Results[ns]
+		delta := compareNamespace(ns, previous, result)
+		delta.NewNamespace = !existed
+		if delta.HasChanges() {
+			comparison.Deltas = append(comparison.Deltas, delta)
+		}
+	}
+
+	for ns, previous := range s.Report.Results {
+		if _, scanned := current[ns]; scanned {
+			comparison.PreviousManual += previous.ManualResources
+			continue
+		}
+		if previous.ManualResources > 0 {
+			comparison.MissingNamespaces = append(comparison.MissingNamespaces, ns)
+		}
+	}
+
+	sort.Slice(comparison.Deltas, func(i, j int) bool {
+		return comparison.Deltas[i].Namespace < comparison.Deltas[j].Namespace
+	})
+	sort.Strings(comparison.MissingNamespaces)
+
+	return comparison
+}
+
+func compareNamespace(namespace string, previous, current domain.AnalysisResult) domain.NamespaceDelta {
+	delta := domain.NamespaceDelta{
+		Namespace:      namespace,
+		PreviousManual: previous.ManualResources,
+		CurrentManual:  current.ManualResources,
+		Incomplete:     current.IsIncomplete(),
+	}
+
+	previousManual := indexResources(previous.ManualResourceList)
+	currentManual := indexResources(current.ManualResourceList)
+	// ArgoCD뿐 아니라 managers 규칙의 도구(Helm, Flux 등)가 관리하게 된 리소스도 편입으로 본다
+	currentManaged := indexResources(current.ArgoCDResourceList)
+	for key, id := range indexResources(current.OtherManagedResourceList) {
+		currentManaged[key] = id
+	}
+
+	for key, id := range currentManual {
+		if _, ok := previousManual[key]; !ok {
+			delta.Appeared = append(delta.Appeared, id)
+		}
+	}
+
+	for key, id := range previousManual {
+		if _, ok := currentManual[key]; ok {
+			continue
+		}
+		if _, ok := currentManaged[key]; ok {
+			delta.Adopted = append(delta.Adopted, id)
+			continue
+		}
+		// 일부 리소스 타입을 조회하지 못했다면 발견되지 않은 리소스가 실제로 사라졌는지 알 수 없다
+		if !delta.Incomplete {
+			delta.Resolved = append(delta.Resolved, id)
+		}
+	}
+
+	sortIdentifiers(delta.Appeared)
+	sortIdentifiers(delta.Adopted)
+	sortIdentifiers(delta.Resolved)
+	return delta
+}
+
+// apiVersion은 버전 업그레이드로 바뀔 수 있으므로 Kind와 이름으로만 식별한다
+func indexResources(resources []domain.KubernetesResource) map[string]domain.ResourceIdentifier {
+	index := make(map[string]domain.ResourceIdentifier, len(resources))
+	for _, resource := range resources {
+		id := resource.Identifier
+		index[strings.Join([]string{id.Kind, id.Name}, "/")] = id
+	}
+	return index
+}
+
+func sortIdentifiers(ids []domain.ResourceIdentifier) {
+	sort.Slice(ids, func(i, j int) bool {
+		if ids[i].Kind != ids[j].Kind {
+			return ids[i].Kind < ids[j].Kind
+		}
+		return ids[i].Name < ids[j].Name
+	})
+}
@@ -0,0 +1,181 @@
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/reporter"
+)
+
+func resource(kind, name string) domain.KubernetesResource {
+	return domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: kind, Name: name},
+	}
+}
+
+func TestSnapshot_Compare(t *testing.T) {
+	previousTime := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	snapshot := &Snapshot{
+		Path: "reports/previous.json",
+		Report: reporter.JSONReport{
+			Metadata: reporter.JSONReportMetadata{StartTime: previousTime},
+			Results: map[string]domain.AnalysisResult{
+				"app": {
+					ManualResources: 3,
+					ManualResourceList: []domain.KubernetesResource{
+						resource("ConfigMap", "adopted"),
+						resource("Secret", "deleted"),
+						resource("Secret", "kept"),
+					},
+				},
+				"stable": {
+					ManualResources:    1,
+					ManualResourceList: []domain.KubernetesResource{resource("Secret", "same")},
+				},
+				"partial": {
+					ManualResources: 2,
+					ManualResourceList: []domain.KubernetesResource{
+						resource("Secret", "unknown"),
+						resource("Deployment", "helm-adopted"),
+					},
+				},
+				"unscanned": {
+					ManualResources:    2,
+					ManualResourceList: []domain.KubernetesResource{resource("Secret", "a"), resource("Secret", "b")},
+				},
+			},
+		},
+	}
+
+	current := map[string]domain.AnalysisResult{
+		"app": {
+			ManualResources: 2,
+			ManualResourceList: []domain.KubernetesResource{
+				resource("Secret", "kept"),
+				resource("Deployment", "hotfix"),
+			},
+			ArgoCDResourceList: []domain.KubernetesResource{resource("ConfigMap", "adopted")},
+		},
+		"stable": {
+			ManualResources:    1,
+			ManualResourceList: []domain.KubernetesResource{resource("Secret", "same")},
+		},
+		"fresh": {
+			ManualResources:    1,
+			ManualResourceList: []domain.KubernetesResource{resource("Secret", "new")},
+		},
+		"partial": {
+			OtherManagedResourceList: []domain.KubernetesResource{resource("Deployment", "helm-adopted")},
+			FailedResourceTypes:      []domain.ResourceTypeFailure{{ResourceType: "secrets", Reason: "forbidden"}},
+		},
+	}
+
+	comparison := snapshot.Compare(current)
+
+	if comparison.PreviousReport != "reports/previous.json" || !comparison.PreviousStartTime.Equal(previousTime) {
+		t.Errorf("이전 리포트 정보가 잘못되었습니다: %+v", comparison)
+	}
+	if comparison.PreviousManual != 6 || comparison.CurrentManual != 4 {
+		t.Errorf("PreviousManual/CurrentManual = %v/%v, want 6/4", comparison.PreviousManual, comparison.CurrentManual)
+	}
+	if len(comparison.MissingNamespaces) != 1 || comparison.MissingNamespaces[0] != "unscanned" {
+		t.Errorf("MissingNamespaces = %v, want [unscanned]", comparison.MissingNamespaces)
+	}
+	if len(comparison.Deltas) != 3 {
+		t.Fatalf("Deltas = %+v, want app, fresh, partial", comparison.Deltas)
+	}
+
+	app := comparison.Deltas[0]
+	if app.Namespace != "app" || app.Change() != -1 || app.NewNamespace {
+		t.Errorf("app delta = %+v", app)
+	}
+	if len(app.Appeared) != 1 || app.Appeared[0].Name != "hotfix" {
+		t.Errorf("Appeared = %v, want [hotfix]", app.Appeared)
+	}
+	if len(app.Adopted) != 1 || app.Adopted[0].Name != "adopted" {
+		t.Errorf("Adopted = %v, want [adopted]", app.Adopted)
+	}
+	if len(app.Resolved) != 1 || app.Resolved[0].Name != "deleted" {
+		t.Errorf("Resolved = %v, want [deleted]", app.Resolved)
+	}
+
+	fresh := comparison.Deltas[1]
+	if fresh.Namespace != "fresh" || !fresh.NewNamespace || len(fresh.Appeared) != 1 {
+		t.Errorf("fresh delta = %+v", fresh)
+	}
+
+	// 불완전한 스캔에서는 사라진 리소스를 해소로 보지 않고, 다른 관리 도구로 옮겨간 리소스는 편입으로 본다
+	partial := comparison.Deltas[2]
+	if partial.Namespace != "partial" || !partial.Incomplete {
+		t.Errorf("partial delta = %+v", partial)
+	}
+	if len(partial.Resolved) != 0 {
+		t.Errorf("Resolved = %v, want 없음", partial.Resolved)
+	}
+	if len(partial.Adopted) != 1 || partial.Adopted[0].Name != "helm-adopted" {
+		t.Errorf("Adopted = %v, want [helm-adopted]", partial.Adopted)
+	}
+}
+
+func TestResolveReportPath(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := ResolveReportPath(dir); err == nil {
+		t.Error("JSON 리포트가 없는 디렉토리에서 에러를 반환해야 합니다")
+	}
+
+	older := filepath.Join(dir, "20240101_000000.json")
+	newer := filepath.Join(dir, "20240108_000000.json")
+	for _, path := range []string{older, newer, filepath.Join(dir, "20240108_000000.md")} {
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	oldTime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(older, oldTime, oldTime); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ResolveReportPath(dir)
+	if err != nil {
+		t.Fatalf("ResolveReportPath() error = %v", err)
+	}
+	if got != newer {
+		t.Errorf("ResolveReportPath() = %v, want %v", got, newer)
+	}
+
+	got, err = ResolveReportPath(older)
+	if err != nil || got != older {
+		t.Errorf("파일 경로는 그대로 반환해야 합니다: %v, %v", got, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	data, _ := json.Marshal(reporter.JSONReport{Results: map[string]domain.AnalysisResult{"app": {ManualResources: 1}}})
+	if err := os.WriteFile(valid, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := Load(valid)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if snapshot.Report.Results["app"].ManualResources != 1 {
+		t.Errorf("Results = %+v", snapshot.Report.Results)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte(`{"metadata": {}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(invalid); err == nil {
+		t.Error("results가 없는 리포트에서 에러를 반환해야 합니다")
+	}
+}
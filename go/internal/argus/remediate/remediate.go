@@ -0,0 +1,414 @@
+package remediate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+type Action string
+
+const (
+	// ActionAnnotate는 리소스에 확인 표시 어노테이션을 추가한다
+	ActionAnnotate Action = "annotate"
+	// ActionAdopt는 지정한 ArgoCD Application의 추적 메타데이터를 추가한다
+	ActionAdopt Action = "adopt"
+	// ActionDeletePlan은 리소스를 삭제하지 않고 삭제 명령 목록만 만든다
+	ActionDeletePlan Action = "delete-plan"
+)
+
+var supportedActions = []Action{ActionAnnotate, ActionAdopt, ActionDeletePlan}
+
+func ParseAction(value string) (Action, error) {
+	for _, action := range supportedActions {
+		if string(action) == value {
+			return action, nil
+		}
+	}
+	return "", fmt.Errorf("지원하지 않는 작업: %s (annotate, adopt, delete-plan 중 하나)", value)
+}
+
+type DryRun string
+
+const (
+	// DryRunNone은 리소스를 실제로 변경한다
+	DryRunNone DryRun = "none"
+	// DryRunClient는 API 서버에 요청하지 않고 변경 내용만 기록한다
+	DryRunClient DryRun = "client"
+	// DryRunServer는 API 서버에 dryRun=All로 요청해 검증만 하고 저장하지 않는다
+	DryRunServer DryRun = "server"
+)
+
+func ParseDryRun(value string) (DryRun, error) {
+	switch DryRun(value) {
+	case DryRunNone, DryRunClient, DryRunServer:
+		return DryRun(value), nil
+	}
+	return "", fmt.Errorf("지원하지 않는 --dry-run 값: %s (none, client, server 중 하나)", value)
+}
+
+const (
+	DefaultAnnotation = "argus.bellsoft/acknowledged"
+
+	TrackingAnnotation = "annotation"
+	TrackingLabel      = "label"
+)
+
+const (
+	StatusApplied = "applied"
+	StatusDryRun  = "dry-run"
+	StatusPlanned = "planned"
+	StatusSkipped = "skipped"
+	StatusFailed  = "failed"
+)
+
+// ErrAborted는 Confirm이 남은 리소스를 모두 건너뛰도록 할 때 반환한다
+var ErrAborted = errors.New("사용자가 중단했습니다")
+
+type Options struct {
+	Action Action
+	DryRun DryRun
+	// annotate 작업에서 추가할 어노테이션
+	AnnotationKey   string
+	AnnotationValue string
+	// adopt 작업에서 리소스를 편입할 Application과 추적 방식 (annotation 또는 label)
+	Application    string
+	TrackingMethod string
+	// Confirm은 리소스마다 작업 전에 호출되며, false이면 건너뛰고 ErrAborted이면 남은 리소스를 모두 건너뛴다.
+	// nil이면 확인 없이 모두 진행한다.
+	Confirm func(resource domain.KubernetesResource, change Change) (bool, error)
+}
+
+func (o *Options) validate() error {
+	switch o.Action {
+	case ActionAnnotate:
+		if o.AnnotationKey == "" {
+			return fmt.Errorf("annotate 작업에는 어노테이션 키가 필요합니다")
+		}
+	case ActionAdopt:
+		if o.Application == "" {
+			return fmt.Errorf("adopt 작업에는 Application 이름이 필요합니다")
+		}
+		if o.TrackingMethod != TrackingAnnotation && o.TrackingMethod != TrackingLabel {
+			return fmt.Errorf("지원하지 않는 추적 방식: %s (annotation 또는 label)", o.TrackingMethod)
+		}
+	case ActionDeletePlan:
+	default:
+		return fmt.Errorf("지원하지 않는 작업: %s", o.Action)
+	}
+	if _, err := ParseDryRun(string(o.DryRun)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Change는 변경 로그의 한 줄이다
+type Change struct {
+	Time       time.Time `json:"time"`
+	Action     Action    `json:"action"`
+	DryRun     DryRun    `json:"dryRun"`
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Name       string    `json:"name"`
+	// Patch는 annotate/adopt 작업의 merge patch, Command는 delete-plan 작업의 삭제 명령이다
+	Patch   string `json:"patch,omitempty"`
+	Command string `json:"command,omitempty"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+func (c Change) Target() string {
+	if c.Namespace == "" {
+		return fmt.Sprintf("%s/%s", c.Kind, c.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", c.Namespace, c.Kind, c.Name)
+}
+
+// Remediator는 스캔 결과의 수동 리소스에 작업 하나를 적용한다
+type Remediator struct {
+	client  dynamic.Interface
+	mapper  meta.RESTMapper
+	options Options
+	now     func() time.Time
+}
+
+func NewRemediator(client dynamic.Interface, mapper meta.RESTMapper, options Options) (*Remediator, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	return &Remediator{client: client, mapper: mapper, options: options, now: time.Now}, nil
+}
+
+// Run은 리소스마다 작업을 적용하고 변경 로그를 반환한다.
+// 리소스 하나의 실패는 로그에 기록하고 계속 진행하며, 컨텍스트가 취소되면 그때까지의 로그와 에러를 반환한다.
+func (r *Remediator) Run(ctx context.Context, resources []domain.KubernetesResource) ([]Change, error) {
+	var changes []Change
+	aborted := false
+
+	for _, resource := range resources {
+		if err := ctx.Err(); err != nil {
+			return changes, err
+		}
+
+		change, mapping, err := r.plan(resource)
+		if err != nil {
+			change.Status, change.Error = StatusFailed, err.Error()
+			changes = append(changes, change)
+			continue
+		}
+
+		if aborted {
+			change.Status = StatusSkipped
+			changes = append(changes, change)
+			continue
+		}
+		if r.options.Confirm != nil {
+			ok, err := r.options.Confirm(resource, change)
+			if errors.Is(err, ErrAborted) {
+				aborted = true
+			} else if err != nil {
+				return changes, err
+			}
+			if !ok {
+				change.Status = StatusSkipped
+				changes = append(changes, change)
+				continue
+			}
+		}
+
+		r.apply(ctx, mapping, &change)
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// plan은 리소스의 GVR을 찾고 적용할 패치 또는 삭제 명령을 만든다
+func (r *Remediator) plan(resource domain.KubernetesResource) (Change, *meta.RESTMapping, error) {
+	id := resource.Identifier
+	namespace := id.Namespace
+	if namespace == domain.ClusterScope {
+		namespace = ""
+	}
+
+	change := Change{
+		Time:       r.now(),
+		Action:     r.options.Action,
+		DryRun:     r.options.DryRun,
+		APIVersion: id.APIVersion,
+		Kind:       id.Kind,
+		Namespace:  namespace,
+		Name:       id.Name,
+	}
+
+	gv, err := schema.ParseGroupVersion(id.APIVersion)
+	if err != nil {
+		return change, nil, fmt.Errorf("잘못된 apiVersion %s: %w", id.APIVersion, err)
+	}
+	mapping, err := r.mapper.RESTMapping(gv.WithKind(id.Kind).GroupKind(), gv.Version)
+	if err != nil {
+		return change, nil, fmt.Errorf("%s %s의 리소스 타입을 찾을 수 없습니다: %w", id.APIVersion, id.Kind, err)
+	}
+
+	switch r.options.Action {
+	case ActionDeletePlan:
+		change.Command = deleteCommand(mapping.Resource, namespace, id.Name)
+	default:
+		patch, err := json.Marshal(r.patch(gv.Group, namespace, id))
+		if err != nil {
+			return change, nil, err
+		}
+		change.Patch = string(patch)
+	}
+	return change, mapping, nil
+}
+
+func (r *Remediator) patch(group, namespace string, id domain.ResourceIdentifier) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	switch {
+	case r.options.Action == ActionAnnotate:
+		metadata["annotations"] = map[string]string{r.options.AnnotationKey: r.options.AnnotationValue}
+	case r.options.TrackingMethod == TrackingLabel:
+		metadata["labels"] = map[string]string{domain.ArgoCDInstanceLabel: r.options.Application}
+	default:
+		metadata["annotations"] = map[string]string{domain.ArgoCDTrackingIDAnnotation: TrackingID(r.options.Application, group, id.Kind, namespace, id.Name)}
+	}
+	return map[string]interface{}{"metadata": metadata}
+}
+
+// TrackingID는 ArgoCD annotation 추적 방식의 tracking-id 값(<application>:<group>/<kind>:<namespace>/<name>)을 만든다
+func TrackingID(application, group, kind, namespace, name string) string {
+	return fmt.Sprintf("%s:%s/%s:%s/%s", application, group, kind, namespace, name)
+}
+
+func deleteCommand(gvr schema.GroupVersionResource, namespace, name string) string {
+	resource := gvr.Resource
+	if gvr.Group != "" {
+		resource += "." + gvr.Group
+	}
+	if namespace == "" {
+		return fmt.Sprintf("kubectl delete %s %s", resource, name)
+	}
+	return fmt.Sprintf("kubectl delete %s %s -n %s", resource, name, namespace)
+}
+
+func (r *Remediator) apply(ctx context.Context, mapping *meta.RESTMapping, change *Change) {
+	if r.options.DryRun == DryRunClient || (r.options.Action == ActionDeletePlan && r.options.DryRun != DryRunServer) {
+		change.Status = StatusPlanned
+		return
+	}
+
+	var dryRun []string
+	if r.options.DryRun == DryRunServer {
+		dryRun = []string{metav1.DryRunAll}
+	}
+
+	resource := r.resourceInterface(mapping, change.Namespace)
+	// 리포트 이후 ArgoCD가 리소스를 편입했을 수 있으므로 요청 직전에 다시 조회하고, 추적 메타데이터가 있으면 건너뛴다
+	current, err := resource.Get(ctx, change.Name, metav1.GetOptions{})
+	if err != nil {
+		change.Status, change.Error = StatusFailed, err.Error()
+		return
+	}
+	if app := trackedApplication(current); app != "" {
+		change.Status = StatusSkipped
+		change.Error = fmt.Sprintf("리포트 이후 ArgoCD Application %s의 추적 메타데이터가 추가되었습니다", app)
+		return
+	}
+
+	if r.options.Action == ActionDeletePlan {
+		// delete-plan은 실제로 삭제하지 않으며, --dry-run=server일 때만 삭제 가능 여부를 서버에서 검증한다
+		err = resource.Delete(ctx, change.Name, metav1.DeleteOptions{DryRun: dryRun})
+	} else {
+		_, err = resource.Patch(ctx, change.Name, types.MergePatchType, []byte(change.Patch), metav1.PatchOptions{DryRun: dryRun, FieldManager: "argus"})
+	}
+
+	switch {
+	case err != nil:
+		change.Status, change.Error = StatusFailed, err.Error()
+	case dryRun != nil:
+		change.Status = StatusDryRun
+	default:
+		change.Status = StatusApplied
+	}
+}
+
+// trackedApplication은 현재 리소스의 tracking-id 어노테이션 또는 instance 라벨이 가리키는 Application을 반환한다
+func trackedApplication(obj *unstructured.Unstructured) string {
+	resource := domain.KubernetesResource{Labels: obj.GetLabels(), Annotations: obj.GetAnnotations()}
+	return resource.ArgoCDApplication()
+}
+
+func (r *Remediator) resourceInterface(mapping *meta.RESTMapping, namespace string) dynamic.ResourceInterface {
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return r.client.Resource(mapping.Resource).Namespace(namespace)
+	}
+	return r.client.Resource(mapping.Resource)
+}
+
+var applicationGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "applications"}
+
+// ApplicationAutoPrune은 Application에 자동 동기화 prune(spec.syncPolicy.automated.prune)이 켜져 있는지 반환한다.
+// application이 "<네임스페이스>_<이름>" 형식이면 해당 네임스페이스에서, 아니면 defaultNamespace에서 조회한다.
+func ApplicationAutoPrune(ctx context.Context, client dynamic.Interface, defaultNamespace, application string) (bool, error) {
+	namespace, name, found := strings.Cut(application, "_")
+	if !found {
+		namespace, name = defaultNamespace, application
+	}
+	obj, err := client.Resource(applicationGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	prune, _, err := unstructured.NestedBool(obj.Object, "spec", "syncPolicy", "automated", "prune")
+	return prune, err
+}
+
+// SelectManualResources는 스캔 결과의 수동 리소스 중 selectors(<네임스페이스>/<Kind>/<이름> 글로브) 하나와 일치하는 리소스를 반환한다.
+// selectors가 비어 있으면 모든 수동 리소스를 반환한다.
+func SelectManualResources(results map[string]domain.AnalysisResult, selectors []string) ([]domain.KubernetesResource, error) {
+	var rules []config.ExclusionRule
+	for _, selector := range selectors {
+		parts := strings.Split(selector, "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("잘못된 선택 패턴: %s (<네임스페이스>/<Kind>/<이름>)", selector)
+		}
+		for _, part := range parts {
+			if err := config.ValidatePattern(part); err != nil {
+				return nil, fmt.Errorf("잘못된 선택 패턴 %s: %w", selector, err)
+			}
+		}
+		rules = append(rules, config.ExclusionRule{Namespace: parts[0], Kind: parts[1], Name: parts[2], Pattern: selector})
+	}
+
+	var selected []domain.KubernetesResource
+	for _, result := range results {
+		for _, resource := range result.ManualResourceList {
+			if len(rules) == 0 || matchesAny(rules, resource.Identifier) {
+				selected = append(selected, resource)
+			}
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		a, b := selected[i].Identifier, selected[j].Identifier
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+	return selected, nil
+}
+
+func matchesAny(rules []config.ExclusionRule, id domain.ResourceIdentifier) bool {
+	for _, rule := range rules {
+		if rule.Match(id.Namespace, id.Kind, id.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteChangeLog는 변경 로그를 JSON Lines 형식으로 기록한다
+func WriteChangeLog(w io.Writer, changes []Change) error {
+	encoder := json.NewEncoder(w)
+	for _, change := range changes {
+		if err := encoder.Encode(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteDeletePlan은 건너뛰거나 검증에 실패하지 않은 삭제 명령을 셸 스크립트로 기록한다
+func WriteDeletePlan(w io.Writer, changes []Change, generatedAt time.Time) error {
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&b, "# argus remediate --action=delete-plan (%s)\n", generatedAt.Format(time.RFC3339))
+	b.WriteString("# 검토 후 직접 실행하세요. argus는 리소스를 삭제하지 않습니다.\n")
+	b.WriteString("set -e\n\n")
+	for _, change := range changes {
+		if change.Command == "" || change.Status == StatusSkipped || change.Status == StatusFailed {
+			continue
+		}
+		fmt.Fprintf(&b, "# %s (%s)\n%s\n", change.Target(), change.APIVersion, change.Command)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
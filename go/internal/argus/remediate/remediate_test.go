@@ -0,0 +1,470 @@
+package remediate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var (
+	configMapGVR   = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	clusterRoleGVR = schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}
+)
+
+func newFakeCluster(t *testing.T) (*dynamicfake.FakeDynamicClient, meta.RESTMapper) {
+	t.Helper()
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+
+	objects := []runtime.Object{
+		newObject("v1", "ConfigMap", "app", "settings"),
+		newObject("v1", "ConfigMap", "app", "debug"),
+		newObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "reader"),
+	}
+	return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...), mapper
+}
+
+func newObject(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func manualResource(apiVersion, kind, namespace, name string) domain.KubernetesResource {
+	return domain.KubernetesResource{Identifier: domain.ResourceIdentifier{APIVersion: apiVersion, Kind: kind, Namespace: namespace, Name: name}}
+}
+
+func newTestRemediator(t *testing.T, client *dynamicfake.FakeDynamicClient, mapper meta.RESTMapper, options Options) *Remediator {
+	t.Helper()
+	r, err := NewRemediator(client, mapper, options)
+	if err != nil {
+		t.Fatalf("NewRemediator() error = %v", err)
+	}
+	r.now = func() time.Time { return time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC) }
+	return r
+}
+
+func TestRemediator_Annotate(t *testing.T) {
+	client, mapper := newFakeCluster(t)
+	r := newTestRemediator(t, client, mapper, Options{
+		Action:          ActionAnnotate,
+		DryRun:          DryRunNone,
+		AnnotationKey:   DefaultAnnotation,
+		AnnotationValue: "sre",
+	})
+
+	changes, err := r.Run(context.Background(), []domain.KubernetesResource{manualResource("v1", "ConfigMap", "app", "settings")})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(changes) != 1 || changes[0].Status != StatusApplied {
+		t.Fatalf("changes = %+v, want applied 1개", changes)
+	}
+
+	obj, err := client.Resource(configMapGVR).Namespace("app").Get(context.Background(), "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := obj.GetAnnotations()[DefaultAnnotation]; got != "sre" {
+		t.Errorf("어노테이션 = %q, want sre", got)
+	}
+}
+
+func TestRemediator_AdoptTrackingMetadata(t *testing.T) {
+	tests := []struct {
+		name           string
+		trackingMethod string
+		resource       domain.KubernetesResource
+		gvr            schema.GroupVersionResource
+		namespace      string
+		check          func(*testing.T, *unstructured.Unstructured)
+	}{
+		{
+			name:           "annotation 추적",
+			trackingMethod: TrackingAnnotation,
+			resource:       manualResource("v1", "ConfigMap", "app", "settings"),
+			gvr:            configMapGVR,
+			namespace:      "app",
+			check: func(t *testing.T, obj *unstructured.Unstructured) {
+				if got := obj.GetAnnotations()[domain.ArgoCDTrackingIDAnnotation]; got != "payments:/ConfigMap:app/settings" {
+					t.Errorf("tracking-id = %q", got)
+				}
+			},
+		},
+		{
+			name:           "label 추적",
+			trackingMethod: TrackingLabel,
+			resource:       manualResource("v1", "ConfigMap", "app", "settings"),
+			gvr:            configMapGVR,
+			namespace:      "app",
+			check: func(t *testing.T, obj *unstructured.Unstructured) {
+				if got := obj.GetLabels()[domain.ArgoCDInstanceLabel]; got != "payments" {
+					t.Errorf("instance 라벨 = %q", got)
+				}
+			},
+		},
+		{
+			name:           "클러스터 범위 리소스",
+			trackingMethod: TrackingAnnotation,
+			resource:       manualResource("rbac.authorization.k8s.io/v1", "ClusterRole", domain.ClusterScope, "reader"),
+			gvr:            clusterRoleGVR,
+			check: func(t *testing.T, obj *unstructured.Unstructured) {
+				if got := obj.GetAnnotations()[domain.ArgoCDTrackingIDAnnotation]; got != "payments:rbac.authorization.k8s.io/ClusterRole:/reader" {
+					t.Errorf("tracking-id = %q", got)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mapper := newFakeCluster(t)
+			r := newTestRemediator(t, client, mapper, Options{
+				Action:         ActionAdopt,
+				DryRun:         DryRunNone,
+				Application:    "payments",
+				TrackingMethod: tt.trackingMethod,
+			})
+
+			changes, err := r.Run(context.Background(), []domain.KubernetesResource{tt.resource})
+			if err != nil || len(changes) != 1 || changes[0].Status != StatusApplied {
+				t.Fatalf("Run() = %+v, %v", changes, err)
+			}
+
+			obj, err := client.Resource(tt.gvr).Namespace(tt.namespace).Get(context.Background(), tt.resource.Identifier.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, obj)
+		})
+	}
+}
+
+func TestRemediator_SkipsResourcesTrackedSinceReport(t *testing.T) {
+	tracked := newObject("v1", "ConfigMap", "app", "settings")
+	tracked.SetAnnotations(map[string]string{domain.ArgoCDTrackingIDAnnotation: "billing:/ConfigMap:app/settings"})
+	labeled := newObject("v1", "ConfigMap", "app", "debug")
+	labeled.SetLabels(map[string]string{domain.ArgoCDInstanceLabel: "billing"})
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), tracked, labeled)
+	_, mapper := newFakeCluster(t)
+
+	r := newTestRemediator(t, client, mapper, Options{
+		Action:         ActionAdopt,
+		DryRun:         DryRunNone,
+		Application:    "payments",
+		TrackingMethod: TrackingAnnotation,
+	})
+	changes, err := r.Run(context.Background(), []domain.KubernetesResource{
+		manualResource("v1", "ConfigMap", "app", "settings"),
+		manualResource("v1", "ConfigMap", "app", "debug"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range changes {
+		if change.Status != StatusSkipped || !strings.Contains(change.Error, "billing") {
+			t.Errorf("%s = %s (%s), want skipped", change.Target(), change.Status, change.Error)
+		}
+	}
+
+	obj, err := client.Resource(configMapGVR).Namespace("app").Get(context.Background(), "settings", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := obj.GetAnnotations()[domain.ArgoCDTrackingIDAnnotation]; got != "billing:/ConfigMap:app/settings" {
+		t.Errorf("ArgoCD가 설정한 tracking-id가 변경되었습니다: %q", got)
+	}
+}
+
+func TestApplicationAutoPrune(t *testing.T) {
+	newApplication := func(namespace, name string, automated map[string]interface{}) *unstructured.Unstructured {
+		obj := newObject("argoproj.io/v1alpha1", "Application", namespace, name)
+		if automated != nil {
+			obj.Object["spec"] = map[string]interface{}{"syncPolicy": map[string]interface{}{"automated": automated}}
+		}
+		return obj
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{applicationGVR: "ApplicationList"},
+		newApplication("argocd", "payments", map[string]interface{}{"prune": true}),
+		newApplication("argocd", "manual-sync", nil),
+		newApplication("team-a", "web", map[string]interface{}{"prune": false}),
+	)
+
+	tests := []struct {
+		name        string
+		application string
+		want        bool
+		wantErr     bool
+	}{
+		{name: "자동 prune", application: "payments", want: true},
+		{name: "자동 동기화 없음", application: "manual-sync"},
+		{name: "다른 네임스페이스의 Application", application: "team-a_web"},
+		{name: "없는 Application", application: "missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplicationAutoPrune(context.Background(), client, "argocd", tt.application)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ApplicationAutoPrune(%s) = %v, %v, want %v", tt.application, got, err, tt.want)
+			}
+		})
+	}
+}
+
+// dryRunClient는 fake dynamic client가 무시하는 dryRun 옵션을 기록하고, API 서버처럼 dryRun 요청은 저장하지 않는다
+type dryRunClient struct {
+	dynamic.Interface
+	dryRuns []string
+}
+
+func (c *dryRunClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	resource := c.Interface.Resource(gvr)
+	return &dryRunResource{NamespaceableResourceInterface: resource, target: resource, client: c}
+}
+
+type dryRunResource struct {
+	dynamic.NamespaceableResourceInterface
+	target dynamic.ResourceInterface
+	client *dryRunClient
+}
+
+func (r *dryRunResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &dryRunResource{NamespaceableResourceInterface: r.NamespaceableResourceInterface, target: r.NamespaceableResourceInterface.Namespace(namespace), client: r.client}
+}
+
+func (r *dryRunResource) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return r.target.Get(ctx, name, opts, subresources...)
+}
+
+func (r *dryRunResource) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if len(opts.DryRun) > 0 {
+		r.client.dryRuns = append(r.client.dryRuns, "patch "+name)
+		return r.target.Get(ctx, name, metav1.GetOptions{})
+	}
+	return r.target.Patch(ctx, name, pt, data, opts, subresources...)
+}
+
+func (r *dryRunResource) Delete(ctx context.Context, name string, opts metav1.DeleteOptions, subresources ...string) error {
+	if len(opts.DryRun) > 0 {
+		r.client.dryRuns = append(r.client.dryRuns, "delete "+name)
+		_, err := r.target.Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+	return r.target.Delete(ctx, name, opts, subresources...)
+}
+
+func TestRemediator_ServerDryRun(t *testing.T) {
+	fake, mapper := newFakeCluster(t)
+	client := &dryRunClient{Interface: fake}
+	r, err := NewRemediator(client, mapper, Options{
+		Action:          ActionAnnotate,
+		DryRun:          DryRunServer,
+		AnnotationKey:   DefaultAnnotation,
+		AnnotationValue: "true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err := r.Run(context.Background(), []domain.KubernetesResource{manualResource("v1", "ConfigMap", "app", "settings")})
+	if err != nil || len(changes) != 1 || changes[0].Status != StatusDryRun {
+		t.Fatalf("Run() = %+v, %v", changes, err)
+	}
+	if len(client.dryRuns) != 1 || client.dryRuns[0] != "patch settings" {
+		t.Errorf("dryRun 요청 = %v, want [patch settings]", client.dryRuns)
+	}
+
+	obj, _ := fake.Resource(configMapGVR).Namespace("app").Get(context.Background(), "settings", metav1.GetOptions{})
+	if _, ok := obj.GetAnnotations()[DefaultAnnotation]; ok {
+		t.Error("--dry-run=server에서 리소스가 변경되었습니다")
+	}
+}
+
+func TestRemediator_ClientDryRunMakesNoRequests(t *testing.T) {
+	client, mapper := newFakeCluster(t)
+	r := newTestRemediator(t, client, mapper, Options{Action: ActionAdopt, DryRun: DryRunClient, Application: "payments", TrackingMethod: TrackingAnnotation})
+
+	changes, _ := r.Run(context.Background(), []domain.KubernetesResource{manualResource("v1", "ConfigMap", "app", "settings")})
+	if len(changes) != 1 || changes[0].Status != StatusPlanned || changes[0].Patch == "" {
+		t.Errorf("changes = %+v, want planned 1개", changes)
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("API 요청 = %v, want 없음", client.Actions())
+	}
+}
+
+func TestRemediator_DeletePlan(t *testing.T) {
+	client, mapper := newFakeCluster(t)
+	r := newTestRemediator(t, client, mapper, Options{Action: ActionDeletePlan, DryRun: DryRunNone})
+
+	resources := []domain.KubernetesResource{
+		manualResource("v1", "ConfigMap", "app", "debug"),
+		manualResource("rbac.authorization.k8s.io/v1", "ClusterRole", domain.ClusterScope, "reader"),
+	}
+	changes, err := r.Run(context.Background(), resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("delete-plan은 삭제 요청을 보내지 않아야 합니다: %v", client.Actions())
+	}
+
+	var plan bytes.Buffer
+	if err := WriteDeletePlan(&plan, changes, time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"kubectl delete configmaps debug -n app",
+		"kubectl delete clusterroles.rbac.authorization.k8s.io reader\n",
+	} {
+		if !strings.Contains(plan.String(), want) {
+			t.Errorf("삭제 계획에 %q가 없습니다:\n%s", want, plan.String())
+		}
+	}
+}
+
+func TestRemediator_DeletePlanServerDryRun(t *testing.T) {
+	fake, mapper := newFakeCluster(t)
+	client := &dryRunClient{Interface: fake}
+	r, err := NewRemediator(client, mapper, Options{Action: ActionDeletePlan, DryRun: DryRunServer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, _ := r.Run(context.Background(), []domain.KubernetesResource{
+		manualResource("v1", "ConfigMap", "app", "debug"),
+		manualResource("v1", "ConfigMap", "app", "missing"),
+	})
+	if len(changes) != 2 || changes[0].Status != StatusDryRun || changes[1].Status != StatusFailed {
+		t.Fatalf("changes = %+v, want dry-run, failed", changes)
+	}
+	if _, err := fake.Resource(configMapGVR).Namespace("app").Get(context.Background(), "debug", metav1.GetOptions{}); err != nil {
+		t.Errorf("삭제 검증 후에도 리소스가 남아 있어야 합니다: %v", err)
+	}
+}
+
+func TestRemediator_Confirm(t *testing.T) {
+	client, mapper := newFakeCluster(t)
+	asked := 0
+	r := newTestRemediator(t, client, mapper, Options{
+		Action:          ActionAnnotate,
+		DryRun:          DryRunNone,
+		AnnotationKey:   DefaultAnnotation,
+		AnnotationValue: "true",
+		Confirm: func(resource domain.KubernetesResource, change Change) (bool, error) {
+			asked++
+			switch resource.Identifier.Name {
+			case "settings":
+				return true, nil
+			case "debug":
+				return false, ErrAborted
+			}
+			return true, nil
+		},
+	})
+
+	resources := []domain.KubernetesResource{
+		manualResource("v1", "ConfigMap", "app", "settings"),
+		manualResource("v1", "ConfigMap", "app", "debug"),
+		manualResource("rbac.authorization.k8s.io/v1", "ClusterRole", domain.ClusterScope, "reader"),
+		manualResource("example.com/v1", "Widget", "app", "unknown"),
+	}
+	changes, err := r.Run(context.Background(), resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{StatusApplied, StatusSkipped, StatusSkipped, StatusFailed}
+	for i, change := range changes {
+		if change.Status != want[i] {
+			t.Errorf("changes[%d] (%s) = %s, want %s", i, change.Target(), change.Status, want[i])
+		}
+	}
+	if asked != 2 {
+		t.Errorf("확인 요청 = %d번, want 2 (중단 후에는 묻지 않음)", asked)
+	}
+}
+
+func TestWriteChangeLog(t *testing.T) {
+	changes := []Change{
+		{Action: ActionAnnotate, Kind: "ConfigMap", Namespace: "app", Name: "settings", Status: StatusApplied},
+		{Action: ActionAnnotate, Kind: "ConfigMap", Namespace: "app", Name: "debug", Status: StatusSkipped},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteChangeLog(&buf, changes); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("변경 로그 = %d줄, want 2", len(lines))
+	}
+	var decoded Change
+	if err := json.Unmarshal([]byte(lines[1]), &decoded); err != nil || decoded.Status != StatusSkipped {
+		t.Errorf("변경 로그 파싱 = %+v, %v", decoded, err)
+	}
+}
+
+func TestSelectManualResources(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app": {ManualResourceList: []domain.KubernetesResource{
+			manualResource("v1", "ConfigMap", "app", "settings"),
+			manualResource("v1", "Secret", "app", "token"),
+		}},
+		"web": {ManualResourceList: []domain.KubernetesResource{
+			manualResource("v1", "ConfigMap", "web", "settings"),
+		}},
+	}
+
+	all, err := SelectManualResources(results, nil)
+	if err != nil || len(all) != 3 || all[0].Identifier.Kind != "ConfigMap" || all[2].Identifier.Namespace != "web" {
+		t.Errorf("선택 패턴 없음 = %+v, %v", all, err)
+	}
+
+	selected, err := SelectManualResources(results, []string{"*/ConfigMap/set*"})
+	if err != nil || len(selected) != 2 {
+		t.Errorf("*/ConfigMap/set* = %d개, %v, want 2", len(selected), err)
+	}
+
+	if _, err := SelectManualResources(results, []string{"app/ConfigMap"}); err == nil {
+		t.Error("형식이 잘못된 선택 패턴에서 에러를 반환해야 합니다")
+	}
+}
+
+func TestNewRemediator_InvalidOptions(t *testing.T) {
+	client, mapper := newFakeCluster(t)
+	tests := []struct {
+		name    string
+		options Options
+	}{
+		{"Application 없는 adopt", Options{Action: ActionAdopt, DryRun: DryRunNone, TrackingMethod: TrackingAnnotation}},
+		{"어노테이션 키 없는 annotate", Options{Action: ActionAnnotate, DryRun: DryRunNone}},
+		{"잘못된 dry-run", Options{Action: ActionDeletePlan, DryRun: "all"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRemediator(client, mapper, tt.options); err == nil {
+				t.Error("에러를 반환해야 합니다")
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package reporter
+
+import (
+	"sort"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// unknownApplication은 ArgoCD 관리로 판단됐지만 tracking-id/instance 라벨이 없어 Application을 특정할 수 없는 리소스 묶음이다
+const unknownApplication = "(Application 미확인)"
+
+type applicationSummary struct {
+	Name       string
+	Resources  int
+	Namespaces []string
+	// ManualNamespaces는 이 Application이 배포하는 네임스페이스 중 수동 리소스도 있는 네임스페이스이다
+	ManualNamespaces []string
+}
+
+func summarizeApplications(results map[string]domain.AnalysisResult) []applicationSummary {
+	type accumulator struct {
+		resources  int
+		namespaces map[string]bool
+	}
+
+	byApplication := make(map[string]*accumulator)
+	for ns, result := range results {
+		for _, resource := range result.ArgoCDResourceList {
+			name := resource.ArgoCDApplication()
+			if name == "" {
+				name = unknownApplication
+			}
+			acc, ok := byApplication[name]
+			if !ok {
+				acc = &accumulator{namespaces: make(map[string]bool)}
+				byApplication[name] = acc
+			}
+			acc.resources++
+			acc.namespaces[ns] = true
+		}
+	}
+
+	summaries := make([]applicationSummary, 0, len(byApplication))
+	for name, acc := range byApplication {
+		summary := applicationSummary{Name: name, Resources: acc.resources}
+		for ns := range acc.namespaces {
+			summary.Namespaces = append(summary.Namespaces, ns)
+			if results[ns].ManualResources > 0 {
+				summary.ManualNamespaces = append(summary.ManualNamespaces, ns)
+			}
+		}
+		sort.Strings(summary.Namespaces)
+		sort.Strings(summary.ManualNamespaces)
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if (summaries[i].Name == unknownApplication) != (summaries[j].Name == unknownApplication) {
+			return summaries[j].Name == unknownApplication
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries
+}
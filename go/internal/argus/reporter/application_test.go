@@ -0,0 +1,70 @@
+package reporter
+
+import (
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func argoCDResource(kind, name, application string) domain.KubernetesResource {
+	resource := domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{Kind: kind, Name: name},
+		Labels:     map[string]string{},
+	}
+	if application != "" {
+		resource.Labels[domain.ArgoCDInstanceLabel] = application
+	}
+	return resource
+}
+
+func TestSummarizeApplications(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"shop": {
+			ManualResources: 1,
+			ArgoCDResourceList: []domain.KubernetesResource{
+				argoCDResource("Deployment", "web", "shop-app"),
+				argoCDResource("Service", "web", "shop-app"),
+				argoCDResource("ConfigMap", "shared", "platform"),
+			},
+		},
+		"payments": {
+			ArgoCDResourceList: []domain.KubernetesResource{
+				argoCDResource("Deployment", "api", "payments-app"),
+				argoCDResource("ConfigMap", "shared", "platform"),
+				argoCDResource("Secret", "legacy", ""),
+			},
+		},
+	}
+
+	summaries := summarizeApplications(results)
+
+	want := []applicationSummary{
+		{Name: "payments-app", Resources: 1, Namespaces: []string{"payments"}},
+		{Name: "platform", Resources: 2, Namespaces: []string{"payments", "shop"}, ManualNamespaces: []string{"shop"}},
+		{Name: "shop-app", Resources: 2, Namespaces: []string{"shop"}, ManualNamespaces: []string{"shop"}},
+		{Name: unknownApplication, Resources: 1, Namespaces: []string{"payments"}},
+	}
+
+	if len(summaries) != len(want) {
+		t.Fatalf("summarizeApplications() = %+v, want %+v", summaries, want)
+	}
+	for i := range want {
+		got := summaries[i]
+		if got.Name != want[i].Name || got.Resources != want[i].Resources ||
+			!equalStrings(got.Namespaces, want[i].Namespaces) || !equalStrings(got.ManualNamespaces, want[i].ManualNamespaces) {
+			t.Errorf("summaries[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package reporter
+
+import (
+	"sort"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func collectBaselineReviewNamespaces(results map[string]domain.AnalysisResult) []string {
+	var namespaces []string
+	for ns, result := range results {
+		if len(result.BaselineFindings) > 0 {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func countBaselineSuppressed(results map[string]domain.AnalysisResult) int {
+	total := 0
+	for _, result := range results {
+		total += result.BaselineSuppressed
+	}
+	return total
+}
+
+func baselineStatusLabel(status string) string {
+	switch status {
+	case domain.BaselineExpired:
+		return "만료됨"
+	case domain.BaselineStale:
+		return "더 이상 발견되지 않음"
+	default:
+		return status
+	}
+}
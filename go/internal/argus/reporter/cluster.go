@@ -0,0 +1,52 @@
+package reporter
+
+import (
+	"sort"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+type kindCount struct {
+	Kind  string
+	Count int
+}
+
+// clusterScopeResult는 클러스터 범위 분석 결과를 반환한다 (--cluster-scope로 검사하지 않았으면 false)
+func clusterScopeResult(results map[string]domain.AnalysisResult) (domain.AnalysisResult, bool) {
+	result, ok := results[domain.ClusterScope]
+	return result, ok
+}
+
+// countNamespaces는 클러스터 범위 결과를 제외한 네임스페이스 수를 반환한다
+func countNamespaces(results map[string]domain.AnalysisResult) int {
+	if _, ok := results[domain.ClusterScope]; ok {
+		return len(results) - 1
+	}
+	return len(results)
+}
+
+// manualKindCounts는 수동 리소스를 Kind별로 집계해 많은 순으로 정렬한다
+func manualKindCounts(result domain.AnalysisResult) []kindCount {
+	byKind := make(map[string]int)
+	for _, resource := range result.ManualResourceList {
+		byKind[resource.Identifier.Kind]++
+	}
+
+	counts := make([]kindCount, 0, len(byKind))
+	for kind, count := range byKind {
+		counts = append(counts, kindCount{Kind: kind, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Kind < counts[j].Kind
+	})
+	return counts
+}
+
+// markdownAnchor는 GitHub 헤더 앵커 규칙에 맞게 괄호를 제거한다 ("(cluster)" → "cluster")
+func markdownAnchor(namespace string) string {
+	return strings.NewReplacer("(", "", ")", "").Replace(namespace)
+}
@@ -0,0 +1,37 @@
+package reporter
+
+import (
+	"reflect"
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func TestManualKindCounts(t *testing.T) {
+	resource := func(kind, name string) domain.KubernetesResource {
+		return domain.KubernetesResource{Identifier: domain.ResourceIdentifier{Kind: kind, Name: name}}
+	}
+	result := domain.AnalysisResult{ManualResourceList: []domain.KubernetesResource{
+		resource("StorageClass", "fast"),
+		resource("ClusterRole", "a"),
+		resource("ClusterRoleBinding", "a"),
+		resource("ClusterRole", "b"),
+	}}
+
+	want := []kindCount{{Kind: "ClusterRole", Count: 2}, {Kind: "ClusterRoleBinding", Count: 1}, {Kind: "StorageClass", Count: 1}}
+	if got := manualKindCounts(result); !reflect.DeepEqual(got, want) {
+		t.Errorf("manualKindCounts() = %v, want %v", got, want)
+	}
+}
+
+func TestCountNamespaces(t *testing.T) {
+	results := map[string]domain.AnalysisResult{"app": {}, "web": {}}
+	if got := countNamespaces(results); got != 2 {
+		t.Errorf("countNamespaces() = %v, want 2", got)
+	}
+
+	results[domain.ClusterScope] = domain.AnalysisResult{}
+	if got := countNamespaces(results); got != 2 {
+		t.Errorf("클러스터 범위 결과는 네임스페이스 수에서 제외해야 합니다: %v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package reporter
 
 import (
+	"context"
 	"fmt"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/utils/color"
@@ -15,17 +16,23 @@ func NewConsoleReporter() *ConsoleReporter {
 	return &ConsoleReporter{}
 }
 
-func (r *ConsoleReporter) Generate(allResults map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+func (r *ConsoleReporter) Generate(ctx context.Context, allResults map[string]domain.AnalysisResult, run domain.RunInfo) error {
 	var sortedNamespaces []string
 	for ns := range allResults {
 		sortedNamespaces = append(sortedNamespaces, ns)
 	}
 	sort.Strings(sortedNamespaces)
 
+	r.printPartialNotice(run)
+
 	r.printOverallStatistics(allResults, sortedNamespaces)
 
 	r.printSummaryTable(allResults, sortedNamespaces)
 
+	r.printManagerBreakdown(allResults)
+
+	r.printClusterScope(allResults)
+
 	hasManualResources := false
 	var manualNamespaces []string
 	for _, namespace := range sortedNamespaces {
@@ -36,6 +43,15 @@ func (r *ConsoleReporter) Generate(allResults map[string]domain.AnalysisResult,
 		}
 	}
 
+	incompleteNamespaces := collectIncompleteNamespaces(allResults)
+	r.printIncompleteScans(allResults, incompleteNamespaces)
+
+	r.printBaselineReview(allResults, run)
+
+	r.printOrphanedGitOps(allResults, sortedNamespaces)
+
+	r.printApplicationsWithManualNamespaces(allResults)
+
 	if hasManualResources {
 		fmt.Printf("\n%s⚠️ 수동 생성된 리소스가 있는 네임스페이스:%s\n", color.Yellow, color.NC)
 		for _, ns := range manualNamespaces {
@@ -43,18 +59,122 @@ func (r *ConsoleReporter) Generate(allResults map[string]domain.AnalysisResult,
 			fmt.Printf("  - %s: %d개\n", ns, result.ManualResources)
 		}
 		fmt.Printf("\n%s💡 상세 내용은 생성된 마크다운 보고서를 확인하세요%s\n", color.Cyan, color.NC)
+	} else if len(incompleteNamespaces) > 0 {
+		fmt.Printf("\n%s⚠️ 발견된 수동 리소스는 없지만 일부 리소스 타입을 검사하지 못해 결과가 불완전합니다%s\n", color.Yellow, color.NC)
 	} else {
 		fmt.Printf("\n%s✅ 모든 네임스페이스가 ArgoCD로 완전히 관리되고 있습니다!%s\n", color.Green, color.NC)
 	}
 
-	elapsed := time.Since(startTime)
+	elapsed := time.Since(run.StartTime)
 	fmt.Printf("\n%s⏱️ 실행 시간: %.2f초%s\n", color.Cyan, elapsed.Seconds(), color.NC)
 
 	return nil
 }
 
+func (r *ConsoleReporter) printPartialNotice(run domain.RunInfo) {
+	if !run.Partial {
+		return
+	}
+
+	fmt.Printf("\n%s⚠️ 스캔이 중단되어 부분 결과만 표시합니다 (미완료 네임스페이스 %d개)%s\n",
+		color.Yellow, len(run.SkippedNamespaces), color.NC)
+}
+
+func (r *ConsoleReporter) printIncompleteScans(allResults map[string]domain.AnalysisResult, incompleteNamespaces []string) {
+	if len(incompleteNamespaces) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s⚠️ 불완전한 스캔 (%d개 네임스페이스에서 일부 리소스 타입 조회 실패):%s\n",
+		color.Yellow, len(incompleteNamespaces), color.NC)
+	for _, ns := range incompleteNamespaces {
+		for _, failure := range allResults[ns].FailedResourceTypes {
+			fmt.Printf("  - %s: %s (%s)\n", ns, failure.ResourceType, failure.Reason)
+		}
+	}
+}
+
+func (r *ConsoleReporter) printManagerBreakdown(allResults map[string]domain.AnalysisResult) {
+	summaries := summarizeManagers(allResults)
+	if len(summaries) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s🛠️ 관리 도구별 리소스%s\n", color.Bold, color.NC)
+	for _, summary := range summaries {
+		fmt.Printf("  - %-15s %5d개 (%d개 네임스페이스)\n", summary.Name, summary.Resources, len(summary.Namespaces))
+	}
+}
+
+func (r *ConsoleReporter) printOrphanedGitOps(allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
+	total := countOrphanedGitOps(allResults)
+	if total == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s👻 고아 GitOps 리소스 %d개 (존재하지 않는 Application을 가리킴):%s\n", color.Yellow, total, color.NC)
+	for _, ns := range sortedNamespaces {
+		for _, resource := range allResults[ns].OrphanedResourceList {
+			fmt.Printf("  - %s: %s/%s → %s\n", ns, resource.Identifier.Kind, resource.Identifier.Name, resource.ArgoCDApplication())
+		}
+	}
+}
+
+func (r *ConsoleReporter) printApplicationsWithManualNamespaces(allResults map[string]domain.AnalysisResult) {
+	var summaries []applicationSummary
+	for _, summary := range summarizeApplications(allResults) {
+		if len(summary.ManualNamespaces) > 0 {
+			summaries = append(summaries, summary)
+		}
+	}
+	if len(summaries) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s📦 수동 리소스가 있는 네임스페이스에 배포 중인 Application:%s\n", color.Cyan, color.NC)
+	for _, summary := range summaries {
+		fmt.Printf("  - %s: %s\n", summary.Name, strings.Join(summary.ManualNamespaces, ", "))
+	}
+}
+
+func (r *ConsoleReporter) printBaselineReview(allResults map[string]domain.AnalysisResult, run domain.RunInfo) {
+	if run.BaselineFile == "" {
+		return
+	}
+
+	fmt.Printf("\n%s📌 베이스라인 (%s): 수용된 수동 리소스 %d개 숨김%s\n",
+		color.Cyan, run.BaselineFile, countBaselineSuppressed(allResults), color.NC)
+
+	reviewNamespaces := collectBaselineReviewNamespaces(allResults)
+	if len(reviewNamespaces) == 0 {
+		return
+	}
+
+	fmt.Printf("%s⚠️ 재검토가 필요한 베이스라인 항목:%s\n", color.Yellow, color.NC)
+	for _, ns := range reviewNamespaces {
+		for _, finding := range allResults[ns].BaselineFindings {
+			fmt.Printf("  - %s: %s/%s (%s, 담당: %s)\n",
+				ns, finding.Identifier.Kind, finding.Identifier.Name, baselineStatusLabel(finding.Status), finding.Owner)
+		}
+	}
+}
+
+func (r *ConsoleReporter) printClusterScope(allResults map[string]domain.AnalysisResult) {
+	result, ok := clusterScopeResult(allResults)
+	if !ok {
+		return
+	}
+
+	fmt.Printf("\n%s🌐 클러스터 범위 리소스%s\n", color.Bold, color.NC)
+	fmt.Printf("  전체 %d개, ArgoCD 관리 %d개, 기타 도구 관리 %d개, 수동 생성 %d개, 제외 %d개\n",
+		result.TotalResources, result.ArgoCDManaged, result.OtherManaged, result.ManualResources, result.ExcludedDefaults)
+	for _, kc := range manualKindCounts(result) {
+		fmt.Printf("  - %s: %d개\n", kc.Kind, kc.Count)
+	}
+}
+
 func (r *ConsoleReporter) printOverallStatistics(allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
-	totalNamespaces := len(sortedNamespaces)
+	totalNamespaces := countNamespaces(allResults)
 	totalManual := 0
 	totalArgoCD := 0
 	totalResources := 0
@@ -64,13 +184,16 @@ func (r *ConsoleReporter) printOverallStatistics(allResults map[string]domain.An
 	partiallyManagedNamespaces := 0
 	unmanagedNamespaces := 0
 
-	for _, result := range allResults {
+	for ns, result := range allResults {
 		totalManual += result.ManualResources
 		totalArgoCD += result.ArgoCDManaged
 		totalResources += result.TotalResources
 		totalRootResources += result.RootResources
 		totalExcluded += result.ExcludedDefaults
 
+		if ns == domain.ClusterScope {
+			continue
+		}
 		if result.RootResources == 0 {
 			unmanagedNamespaces++
 		} else if result.ManualResources == 0 {
@@ -88,6 +211,8 @@ func (r *ConsoleReporter) printOverallStatistics(allResults map[string]domain.An
 	fmt.Printf("전체 리소스: %d개\n", totalResources)
 	fmt.Printf("최상위 리소스: %d개\n", totalRootResources)
 	fmt.Printf("ArgoCD 관리 리소스: %d개\n", totalArgoCD)
+	fmt.Printf("기타 도구 관리 리소스: %d개\n", countOtherManaged(allResults))
+	fmt.Printf("고아 GitOps 리소스: %d개\n", countOrphanedGitOps(allResults))
 	fmt.Printf("수동 생성 리소스: %d개\n", totalManual)
 	fmt.Printf("제외된 기본 리소스: %d개\n", totalExcluded)
 	fmt.Println(strings.Repeat("-", 60))
@@ -0,0 +1,13 @@
+package reporter
+
+import (
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// decisionRule은 --show-rules 시 "결정 규칙" 열에 표시할 값이다
+func decisionRule(resource domain.KubernetesResource) string {
+	if resource.DecidedBy == nil {
+		return "일치하는 규칙 없음"
+	}
+	return resource.DecidedBy.String()
+}
@@ -0,0 +1,61 @@
+package reporter
+
+import (
+	"sort"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// exclusionSummary는 제외 규칙 하나가 숨긴 리소스 수이다
+type exclusionSummary struct {
+	Rule       domain.DecisionRule
+	Resources  int
+	Namespaces []string
+}
+
+// summarizeExclusions는 규칙별로 제외된 리소스 수를 합산한다.
+// 지나치게 넓은 패턴을 먼저 검토할 수 있도록 제외 수가 많은 규칙부터 정렬한다.
+func summarizeExclusions(results map[string]domain.AnalysisResult) []exclusionSummary {
+	byRule := make(map[domain.DecisionRule]*exclusionSummary)
+	for ns, result := range results {
+		seen := make(map[domain.DecisionRule]bool)
+		for _, resource := range result.ExcludedResourceList {
+			if resource.DecidedBy == nil {
+				continue
+			}
+			rule := *resource.DecidedBy
+			summary, ok := byRule[rule]
+			if !ok {
+				summary = &exclusionSummary{Rule: rule}
+				byRule[rule] = summary
+			}
+			summary.Resources++
+			if !seen[rule] {
+				seen[rule] = true
+				summary.Namespaces = append(summary.Namespaces, ns)
+			}
+		}
+	}
+
+	summaries := make([]exclusionSummary, 0, len(byRule))
+	for _, summary := range byRule {
+		sort.Strings(summary.Namespaces)
+		summaries = append(summaries, *summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Resources != summaries[j].Resources {
+			return summaries[i].Resources > summaries[j].Resources
+		}
+		return summaries[i].Rule.String() < summaries[j].Rule.String()
+	})
+	return summaries
+}
+
+func countExcludedResources(results map[string]domain.AnalysisResult) int {
+	total := 0
+	for _, result := range results {
+		total += len(result.ExcludedResourceList)
+	}
+	return total
+}
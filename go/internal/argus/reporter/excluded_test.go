@@ -0,0 +1,43 @@
+package reporter
+
+import (
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func excludedBy(kind, name, section, pattern string) domain.KubernetesResource {
+	return domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{Kind: kind, Name: name},
+		DecidedBy:  &domain.DecisionRule{Section: section, Pattern: pattern},
+	}
+}
+
+func TestSummarizeExclusions(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"shop": {ExcludedResourceList: []domain.KubernetesResource{
+			excludedBy("Job", "migrate", "exclusions.others", "*/Job/*"),
+			excludedBy("Job", "backup", "exclusions.others", "*/Job/*"),
+			excludedBy("Secret", "sh.helm.release.v1.shop.v1", "patterns.secret_patterns", `^sh\.helm\.release\.v1\.`),
+		}},
+		"payments": {ExcludedResourceList: []domain.KubernetesResource{
+			excludedBy("Job", "settle", "exclusions.others", "*/Job/*"),
+		}},
+		"empty": {},
+	}
+
+	summaries := summarizeExclusions(results)
+
+	if len(summaries) != 2 {
+		t.Fatalf("summarizeExclusions() = %+v, want 2개", summaries)
+	}
+	if got := summaries[0]; got.Rule.Pattern != "*/Job/*" || got.Resources != 3 || !equalStrings(got.Namespaces, []string{"payments", "shop"}) {
+		t.Errorf("summaries[0] = %+v, want */Job/* 3개", got)
+	}
+	if got := summaries[1]; got.Rule.Section != "patterns.secret_patterns" || got.Resources != 1 {
+		t.Errorf("summaries[1] = %+v", got)
+	}
+	if got := countExcludedResources(results); got != 4 {
+		t.Errorf("countExcludedResources() = %v, want 4", got)
+	}
+}
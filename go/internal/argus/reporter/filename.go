@@ -0,0 +1,51 @@
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// DefaultFileNameTemplate는 기존과 동일하게 실행 시각만으로 파일명을 만든다.
+const DefaultFileNameTemplate = "{timestamp}"
+
+// ValidateFileNameTemplate은 보고서가 --output-dir 밖이나 만들어지지 않은 하위 디렉토리에 쓰이지 않도록
+// 경로 구분자가 포함된 템플릿을 거부한다. 치환 값의 구분자는 renderFileName이 '_'로 바꾼다.
+func ValidateFileNameTemplate(template string) error {
+	if strings.ContainsAny(template, `/\`) {
+		return fmt.Errorf("파일명 템플릿에 경로 구분자를 쓸 수 없습니다: %s (디렉토리는 --output-dir로 지정)", template)
+	}
+	if template == "." || template == ".." {
+		return fmt.Errorf("잘못된 파일명 템플릿: %s", template)
+	}
+	return nil
+}
+
+// renderFileName은 파일명 템플릿의 플레이스홀더를 치환한다.
+// 지원: {timestamp}, {date}, {time}, {context}, {cluster}
+func renderFileName(template string, run domain.RunInfo) string {
+	if template == "" {
+		template = DefaultFileNameTemplate
+	}
+
+	replacer := strings.NewReplacer(
+		"{timestamp}", run.StartTime.Format("20060102_150405"),
+		"{date}", run.StartTime.Format("20060102"),
+		"{time}", run.StartTime.Format("150405"),
+		"{context}", sanitizeFileNamePart(run.Context),
+		"{cluster}", sanitizeFileNamePart(run.Cluster),
+	)
+	return replacer.Replace(template)
+}
+
+// sanitizeFileNamePart는 EKS ARN 등 경로 구분자가 포함된 값을 파일명에 안전한 형태로 바꾼다.
+func sanitizeFileNamePart(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, value)
+}
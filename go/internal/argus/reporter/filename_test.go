@@ -0,0 +1,76 @@
+package reporter
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func TestRenderFileName(t *testing.T) {
+	startTime := time.Date(2024, 1, 15, 14, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		template string
+		context  string
+		cluster  string
+		want     string
+	}{
+		{
+			name:     "빈 템플릿은 기본값 사용",
+			template: "",
+			want:     "20240115_143045",
+		},
+		{
+			name:     "고정 파일명",
+			template: "argus-report",
+			want:     "argus-report",
+		},
+		{
+			name:     "컨텍스트와 날짜 조합",
+			template: "argus-{context}-{date}",
+			context:  "prod",
+			want:     "argus-prod-20240115",
+		},
+		{
+			name:     "경로 구분자가 포함된 클러스터",
+			template: "{cluster}_{time}",
+			cluster:  "arn:aws:eks:ap-northeast-2:123:cluster/prod",
+			want:     "arn_aws_eks_ap-northeast-2_123_cluster_prod_143045",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := domain.RunInfo{Context: tt.context, Cluster: tt.cluster, StartTime: startTime}
+			got := renderFileName(tt.template, run)
+			if got != tt.want {
+				t.Errorf("renderFileName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFileNameTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "기본 템플릿", template: DefaultFileNameTemplate},
+		{name: "플레이스홀더 조합", template: "argus-{cluster}-{date}"},
+		{name: "슬래시 포함", template: "{date}/argus", wantErr: true},
+		{name: "역슬래시 포함", template: `{date}\argus`, wantErr: true},
+		{name: "상위 디렉토리", template: "..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFileNameTemplate(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFileNameTemplate(%q) error = %v, wantErr %v", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
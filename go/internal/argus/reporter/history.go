@@ -0,0 +1,35 @@
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func formatChange(change int) string {
+	if change > 0 {
+		return fmt.Sprintf("+%d", change)
+	}
+	return fmt.Sprintf("%d", change)
+}
+
+func formatIdentifiers(ids []domain.ResourceIdentifier) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, id.Kind+"/"+id.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func scopeLabels(namespaces []string) []string {
+	labels := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		labels = append(labels, domain.ScopeLabel(ns))
+	}
+	return labels
+}
@@ -2,6 +2,7 @@ package reporter
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 	"html/template"
@@ -13,16 +14,34 @@ import (
 )
 
 type HTMLReporter struct {
-	outputDir string
+	outputDir        string
+	fileNameTemplate string
+	showRules        bool
+	showExcluded     bool
 }
 
-func NewHTMLReporter(outputDir string) *HTMLReporter {
+func NewHTMLReporter(outputDir, fileNameTemplate string) *HTMLReporter {
 	return &HTMLReporter{
-		outputDir: outputDir,
+		outputDir:        outputDir,
+		fileNameTemplate: fileNameTemplate,
 	}
 }
 
-func (r *HTMLReporter) Generate(results map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+// SetShowDecisionRules는 리소스 목록에 분류를 결정한 규칙 열을 추가할지 설정한다
+func (r *HTMLReporter) SetShowDecisionRules(show bool) {
+	r.showRules = show
+}
+
+// SetShowExcluded는 제외된 리소스 감사 섹션을 추가할지 설정한다 (기본으로 접혀 있음)
+func (r *HTMLReporter) SetShowExcluded(show bool) {
+	r.showExcluded = show
+}
+
+func (r *HTMLReporter) Generate(ctx context.Context, results map[string]domain.AnalysisResult, run domain.RunInfo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if err := os.MkdirAll(r.outputDir, 0755); err != nil {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
@@ -39,6 +58,11 @@ func (r *HTMLReporter) Generate(results map[string]domain.AnalysisResult, contex
 		"fontFamily": func() string {
 			return fontFamily
 		},
+		"baselineStatus":    baselineStatusLabel,
+		"scopeLabel":        domain.ScopeLabel,
+		"formatChange":      formatChange,
+		"formatIdentifiers": formatIdentifiers,
+		"decisionRule":      decisionRule,
 		"getManagedStatus": func(result domain.AnalysisResult) string {
 			if result.RootResources == 0 {
 				return "➖"
@@ -68,27 +92,63 @@ func (r *HTMLReporter) Generate(results map[string]domain.AnalysisResult, contex
 	stats := r.calculateStatistics(results)
 
 	data := struct {
-		Context             string
-		Cluster             string
-		StartTime           time.Time
-		TotalNamespaces     int
-		ActionRequired      int
-		AllResults          map[string]domain.AnalysisResult
-		Results             map[string]domain.AnalysisResult
-		SortedNamespaces    []string
-		AllSortedNamespaces []string
-		Stats               map[string]int
+		Context              string
+		Cluster              string
+		StartTime            time.Time
+		TotalNamespaces      int
+		ActionRequired       int
+		AllResults           map[string]domain.AnalysisResult
+		Results              map[string]domain.AnalysisResult
+		SortedNamespaces     []string
+		AllSortedNamespaces  []string
+		Stats                map[string]int
+		IncompleteNamespaces []string
+		Partial              bool
+		SkippedNamespaces    []string
+		BaselineFile         string
+		BaselineSuppressed   int
+		BaselineNamespaces   []string
+		Comparison           *domain.ScanComparison
+		Applications         []applicationSummary
+		Managers             []managerSummary
+		ClusterScope         *domain.AnalysisResult
+		ClusterKinds         []kindCount
+		ShowRules            bool
+		ShowExcluded         bool
+		ExcludedCount        int
+		Exclusions           []exclusionSummary
 	}{
-		Context:             context,
-		Cluster:             cluster,
-		StartTime:           startTime,
-		TotalNamespaces:     len(results),
-		ActionRequired:      len(actionRequired),
-		AllResults:          results,
-		Results:             actionRequired,
-		SortedNamespaces:    []string{},
-		AllSortedNamespaces: sortedNamespaces,
-		Stats:               stats,
+		Context:              run.Context,
+		Cluster:              run.Cluster,
+		StartTime:            run.StartTime,
+		TotalNamespaces:      countNamespaces(results),
+		ActionRequired:       len(actionRequired),
+		AllResults:           results,
+		Results:              actionRequired,
+		SortedNamespaces:     []string{},
+		AllSortedNamespaces:  sortedNamespaces,
+		Stats:                stats,
+		IncompleteNamespaces: collectIncompleteNamespaces(results),
+		Partial:              run.Partial,
+		SkippedNamespaces:    run.SkippedNamespaces,
+		BaselineFile:         run.BaselineFile,
+		BaselineSuppressed:   countBaselineSuppressed(results),
+		BaselineNamespaces:   collectBaselineReviewNamespaces(results),
+		Comparison:           run.Comparison,
+		Applications:         summarizeApplications(results),
+		Managers:             summarizeManagers(results),
+		ShowRules:            r.showRules,
+	}
+
+	if r.showExcluded {
+		data.ShowExcluded = true
+		data.ExcludedCount = countExcludedResources(results)
+		data.Exclusions = summarizeExclusions(results)
+	}
+
+	if result, ok := clusterScopeResult(results); ok {
+		data.ClusterScope = &result
+		data.ClusterKinds = manualKindCounts(result)
 	}
 
 	for ns := range actionRequired {
@@ -101,7 +161,7 @@ func (r *HTMLReporter) Generate(results map[string]domain.AnalysisResult, contex
 		return fmt.Errorf("failed to execute template: %w", err)
 	}
 
-	filename := filepath.Join(r.outputDir, fmt.Sprintf("%s.html", startTime.Format("20060102_150405")))
+	filename := filepath.Join(r.outputDir, renderFileName(r.fileNameTemplate, run)+".html")
 
 	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
 		return fmt.Errorf("failed to write HTML file: %w", err)
@@ -116,6 +176,8 @@ func (r *HTMLReporter) calculateStatistics(results map[string]domain.AnalysisRes
 		"totalResources":              0,
 		"totalRootResources":          0,
 		"totalArgoCD":                 0,
+		"totalOther":                  0,
+		"totalOrphaned":               0,
 		"totalManual":                 0,
 		"totalExcluded":               0,
 		"completelyManagedNamespaces": 0,
@@ -123,13 +185,18 @@ func (r *HTMLReporter) calculateStatistics(results map[string]domain.AnalysisRes
 		"unmanagedNamespaces":         0,
 	}
 
-	for _, result := range results {
+	for ns, result := range results {
 		stats["totalResources"] += result.TotalResources
 		stats["totalRootResources"] += result.RootResources
 		stats["totalArgoCD"] += result.ArgoCDManaged
+		stats["totalOther"] += result.OtherManaged
+		stats["totalOrphaned"] += result.OrphanedGitOps
 		stats["totalManual"] += result.ManualResources
 		stats["totalExcluded"] += result.ExcludedDefaults
 
+		if ns == domain.ClusterScope {
+			continue
+		}
 		if result.RootResources == 0 {
 			stats["unmanagedNamespaces"]++
 		} else if result.ManualResources == 0 {
@@ -285,6 +352,28 @@ const htmlTemplate = `<!DOCTYPE html>
             color: #7f8c8d;
             font-size: 13px;
         }
+        .warning-section {
+            background-color: #fff8e1;
+            border-left: 4px solid #f39c12;
+            padding: 20px;
+            border-radius: 8px;
+            margin: 30px 0;
+        }
+        .warning-section h2 {
+            color: #d35400;
+            font-size: 20px;
+            margin-bottom: 10px;
+        }
+        .excluded-section {
+            margin: 30px 0;
+        }
+        .excluded-section summary {
+            cursor: pointer;
+            font-size: 20px;
+            font-weight: bold;
+            color: #2c3e50;
+            margin-bottom: 10px;
+        }
         .footer {
             margin-top: 40px;
             padding-top: 20px;
@@ -301,7 +390,16 @@ const htmlTemplate = `<!DOCTYPE html>
         <div class="header-info">
             <div>컨텍스트: {{.Context}} | 클러스터: {{.Cluster}}</div>
             <div>검사 시작: {{formatTime .StartTime}} | 소요 시간: {{duration .StartTime}}</div>
+            {{if .BaselineFile}}<div>베이스라인: {{.BaselineFile}} (수용된 수동 리소스 {{.BaselineSuppressed}}개 숨김)</div>{{end}}
+        </div>
+
+        {{if .Partial}}
+        <div class="warning-section">
+            <h2>⚠️ 부분 리포트</h2>
+            <p>스캔이 중단되어 {{len .SkippedNamespaces}}개 네임스페이스가 분석되지 않았습니다.</p>
+            <p class="created-by">{{range $i, $ns := .SkippedNamespaces}}{{if $i}}, {{end}}{{$ns}}{{end}}</p>
         </div>
+        {{end}}
 
         <div class="summary">
             <h2>📊 전체 통계</h2>
@@ -322,6 +420,14 @@ const htmlTemplate = `<!DOCTYPE html>
                     <div class="stat-number">{{index .Stats "totalArgoCD"}}</div>
                     <div class="stat-label">ArgoCD 관리</div>
                 </div>
+                <div class="stat-card">
+                    <div class="stat-number">{{index .Stats "totalOther"}}</div>
+                    <div class="stat-label">기타 도구 관리</div>
+                </div>
+                <div class="stat-card">
+                    <div class="stat-number">{{index .Stats "totalOrphaned"}}</div>
+                    <div class="stat-label">고아 GitOps</div>
+                </div>
                 <div class="stat-card">
                     <div class="stat-number">{{index .Stats "totalManual"}}</div>
                     <div class="stat-label">수동 생성</div>
@@ -354,6 +460,8 @@ const htmlTemplate = `<!DOCTYPE html>
                     <th style="text-align: right;">전체 리소스</th>
                     <th style="text-align: right;">최상위 리소스</th>
                     <th style="text-align: right;">ArgoCD 관리 중</th>
+                    <th style="text-align: right;">기타 도구 관리</th>
+                    <th style="text-align: right;">고아 GitOps</th>
                     <th style="text-align: right;">수동 생성</th>
                     <th style="text-align: right;">기본 리소스</th>
                 </tr>
@@ -367,6 +475,8 @@ const htmlTemplate = `<!DOCTYPE html>
                     <td style="text-align: right;">{{$result.TotalResources}}</td>
                     <td style="text-align: right;">{{$result.RootResources}}</td>
                     <td style="text-align: right;">{{$result.ArgoCDManaged}}</td>
+                    <td style="text-align: right;">{{$result.OtherManaged}}</td>
+                    <td style="text-align: right;">{{$result.OrphanedGitOps}}</td>
                     <td style="text-align: right;">{{$result.ManualResources}}</td>
                     <td style="text-align: right;">{{$result.ExcludedDefaults}}</td>
                 </tr>
@@ -379,12 +489,217 @@ const htmlTemplate = `<!DOCTYPE html>
                     <td style="text-align: right;">{{index .Stats "totalResources"}}</td>
                     <td style="text-align: right;">{{index .Stats "totalRootResources"}}</td>
                     <td style="text-align: right;">{{index .Stats "totalArgoCD"}}</td>
+                    <td style="text-align: right;">{{index .Stats "totalOther"}}</td>
+                    <td style="text-align: right;">{{index .Stats "totalOrphaned"}}</td>
                     <td style="text-align: right;">{{index .Stats "totalManual"}}</td>
                     <td style="text-align: right;">{{index .Stats "totalExcluded"}}</td>
                 </tr>
             </tfoot>
         </table>
 
+        {{if .Managers}}
+        <h2 style="margin: 30px 0 20px;">🛠️ 관리 도구별 리소스</h2>
+        <table class="resources-table">
+            <thead>
+                <tr>
+                    <th>관리 도구</th>
+                    <th style="text-align: right;">리소스 수</th>
+                    <th>네임스페이스</th>
+                </tr>
+            </thead>
+            <tbody>
+                {{range $manager := .Managers}}
+                <tr>
+                    <td class="resource-kind">{{$manager.Name}}</td>
+                    <td style="text-align: right;">{{$manager.Resources}}</td>
+                    <td>{{range $i, $ns := $manager.Namespaces}}{{if $i}}, {{end}}{{$ns}}{{end}}</td>
+                </tr>
+                {{end}}
+            </tbody>
+        </table>
+        {{end}}
+
+        {{with .ClusterScope}}
+        <h2 style="margin: 30px 0 20px;">🌐 클러스터 범위 리소스</h2>
+        <p class="created-by" style="margin-bottom: 15px;">전체 {{.TotalResources}}개 · ArgoCD 관리 {{.ArgoCDManaged}}개 · 기타 도구 관리 {{.OtherManaged}}개 · 수동 생성 {{.ManualResources}}개 · 제외 {{.ExcludedDefaults}}개</p>
+        {{if $.ClusterKinds}}
+        <table class="resources-table">
+            <thead>
+                <tr>
+                    <th>Kind</th>
+                    <th style="text-align: right;">수동 리소스</th>
+                </tr>
+            </thead>
+            <tbody>
+                {{range $kind := $.ClusterKinds}}
+                <tr>
+                    <td class="resource-kind">{{$kind.Kind}}</td>
+                    <td style="text-align: right;">{{$kind.Count}}</td>
+                </tr>
+                {{end}}
+            </tbody>
+        </table>
+        {{end}}
+        {{end}}
+
+        {{with .Comparison}}
+        <h2 style="margin: 30px 0 10px;">🔄 지난 스캔 이후 변화</h2>
+        <div class="header-info" style="margin-bottom: 15px;">
+            <div>이전 스캔: {{formatTime .PreviousStartTime}} ({{.PreviousReport}})</div>
+            <div>수동 리소스: {{.PreviousManual}}개 → {{.CurrentManual}}개 ({{formatChange .Change}})</div>
+            {{if .MissingNamespaces}}<div>이번에 검사하지 않은 네임스페이스: {{range $i, $ns := .MissingNamespaces}}{{if $i}}, {{end}}{{scopeLabel $ns}}{{end}}</div>{{end}}
+        </div>
+        {{if .Deltas}}
+        <table class="resources-table">
+            <thead>
+                <tr>
+                    <th>네임스페이스</th>
+                    <th style="text-align: right;">이전</th>
+                    <th style="text-align: right;">현재</th>
+                    <th style="text-align: right;">변화</th>
+                    <th>신규 수동 리소스</th>
+                    <th>관리 도구 편입</th>
+                    <th>삭제/제외</th>
+                </tr>
+            </thead>
+            <tbody>
+                {{range $delta := .Deltas}}
+                <tr>
+                    <td>{{scopeLabel $delta.Namespace}}{{if $delta.NewNamespace}} (신규){{end}}{{if $delta.Incomplete}} (불완전){{end}}</td>
+                    <td style="text-align: right;">{{$delta.PreviousManual}}</td>
+                    <td style="text-align: right;">{{$delta.CurrentManual}}</td>
+                    <td style="text-align: right;">{{formatChange $delta.Change}}</td>
+                    <td class="resource-name">{{formatIdentifiers $delta.Appeared}}</td>
+                    <td class="resource-name">{{formatIdentifiers $delta.Adopted}}</td>
+                    <td class="resource-name">{{formatIdentifiers $delta.Resolved}}</td>
+                </tr>
+                {{end}}
+            </tbody>
+        </table>
+        {{else}}
+        <p>변화 없음</p>
+        {{end}}
+        {{end}}
+
+        {{if .IncompleteNamespaces}}
+        <div class="warning-section">
+            <h2>⚠️ 불완전한 스캔</h2>
+            <p>{{len .IncompleteNamespaces}}개 네임스페이스에서 일부 리소스 타입을 조회하지 못했습니다. 아래 리소스 타입은 검사 결과에 포함되지 않았습니다.</p>
+            <table class="resources-table" style="margin-top: 15px;">
+                <thead>
+                    <tr>
+                        <th>네임스페이스</th>
+                        <th>리소스 타입</th>
+                        <th>원인</th>
+                        <th>메시지</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range $ns := .IncompleteNamespaces}}
+                    {{$result := index $.AllResults $ns}}
+                    {{range $failure := $result.FailedResourceTypes}}
+                    <tr>
+                        <td>{{$ns}}</td>
+                        <td class="resource-name">{{$failure.ResourceType}}</td>
+                        <td>{{$failure.Reason}}</td>
+                        <td class="created-by">{{$failure.Message}}</td>
+                    </tr>
+                    {{end}}
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+        {{end}}
+
+        {{if index .Stats "totalOrphaned"}}
+        <div class="warning-section">
+            <h2>👻 고아 GitOps 리소스</h2>
+            <p>ArgoCD 추적 정보가 있지만 가리키는 Application이 존재하지 않는 리소스입니다. Application이 삭제되었거나 이름이 바뀌었을 수 있습니다.</p>
+            <table class="resources-table" style="margin-top: 15px;">
+                <thead>
+                    <tr>
+                        <th>네임스페이스</th>
+                        <th>리소스</th>
+                        <th>참조 Application</th>
+                        {{if $.ShowRules}}<th>결정 규칙</th>{{end}}
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range $ns := .AllSortedNamespaces}}
+                    {{$result := index $.AllResults $ns}}
+                    {{range $resource := $result.OrphanedResourceList}}
+                    <tr>
+                        <td>{{$ns}}</td>
+                        <td class="resource-name">{{$resource.Identifier.Kind}}/{{$resource.Identifier.Name}}</td>
+                        <td class="resource-name">{{$resource.ArgoCDApplication}}</td>
+                        {{if $.ShowRules}}<td class="created-by">{{decisionRule $resource}}</td>{{end}}
+                    </tr>
+                    {{end}}
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+        {{end}}
+
+        {{if .Applications}}
+        <h2 style="margin: 30px 0 10px;">📦 ArgoCD Application별 현황</h2>
+        <p class="created-by" style="margin-bottom: 15px;">"수동 리소스가 있는 네임스페이스"는 해당 Application이 배포하는 네임스페이스 중 수동 리소스도 함께 있는 곳입니다.</p>
+        <table class="resources-table">
+            <thead>
+                <tr>
+                    <th>Application</th>
+                    <th style="text-align: right;">리소스 수</th>
+                    <th>네임스페이스</th>
+                    <th>수동 리소스가 있는 네임스페이스</th>
+                </tr>
+            </thead>
+            <tbody>
+                {{range $app := .Applications}}
+                <tr>
+                    <td class="resource-name">{{$app.Name}}</td>
+                    <td style="text-align: right;">{{$app.Resources}}</td>
+                    <td>{{range $i, $ns := $app.Namespaces}}{{if $i}}, {{end}}{{$ns}}{{end}}</td>
+                    <td>{{if $app.ManualNamespaces}}⚠️ {{range $i, $ns := $app.ManualNamespaces}}{{if $i}}, {{end}}{{$ns}}{{end}}{{else}}-{{end}}</td>
+                </tr>
+                {{end}}
+            </tbody>
+        </table>
+        {{end}}
+
+        {{if .BaselineNamespaces}}
+        <div class="warning-section">
+            <h2>📌 재검토가 필요한 베이스라인 항목</h2>
+            <p>만료되었거나 더 이상 수동 리소스로 발견되지 않는 항목입니다. 베이스라인 파일을 갱신하세요.</p>
+            <table class="resources-table" style="margin-top: 15px;">
+                <thead>
+                    <tr>
+                        <th>네임스페이스</th>
+                        <th>리소스</th>
+                        <th>상태</th>
+                        <th>사유</th>
+                        <th>담당자</th>
+                        <th>만료일</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range $ns := .BaselineNamespaces}}
+                    {{$result := index $.AllResults $ns}}
+                    {{range $finding := $result.BaselineFindings}}
+                    <tr>
+                        <td>{{$ns}}</td>
+                        <td class="resource-name">{{$finding.Identifier.Kind}}/{{$finding.Identifier.Name}}</td>
+                        <td>{{baselineStatus $finding.Status}}</td>
+                        <td class="created-by">{{$finding.Reason}}</td>
+                        <td>{{$finding.Owner}}</td>
+                        <td>{{if $finding.Expires}}{{$finding.Expires}}{{else}}-{{end}}</td>
+                    </tr>
+                    {{end}}
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+        {{end}}
+
         {{if .ActionRequired}}
         <h2 style="margin-bottom: 20px; color: #e74c3c;">⚠️ 조치가 필요한 네임스페이스</h2>
         
@@ -402,6 +717,7 @@ const htmlTemplate = `<!DOCTYPE html>
                         <th style="width: 30%;">리소스 이름</th>
                         <th style="width: 20%;">API 버전</th>
                         <th style="width: 30%;">생성 정보</th>
+                        {{if $.ShowRules}}<th>결정 규칙</th>{{end}}
                     </tr>
                 </thead>
                 <tbody>
@@ -411,20 +727,75 @@ const htmlTemplate = `<!DOCTYPE html>
                         <td class="resource-name">{{$resource.Identifier.Name}}</td>
                         <td class="resource-kind">{{$resource.Identifier.APIVersion}}</td>
                         <td class="created-by">
-                            수동으로 생성됨
+                            {{with $resource.CreatedBy}}생성: {{.}}{{else}}수동으로 생성됨{{end}}
+                            {{with $resource.LastModifiedBy}}{{if ne . $resource.CreatedBy}}<br>최종 수정: {{.}}{{end}}{{end}}
                         </td>
+                        {{if $.ShowRules}}<td class="created-by">{{decisionRule $resource}}</td>{{end}}
                     </tr>
                     {{end}}
                 </tbody>
             </table>
         </div>
         {{end}}
+        {{else if .IncompleteNamespaces}}
+        <div style="text-align: center; padding: 40px; color: #d35400;">
+            <h2>⚠️ 발견된 수동 리소스는 없지만 스캔이 불완전합니다</h2>
+        </div>
         {{else}}
         <div style="text-align: center; padding: 40px; color: #27ae60;">
             <h2>✅ 모든 리소스가 ArgoCD로 관리되고 있습니다!</h2>
         </div>
         {{end}}
 
+        {{if .ShowExcluded}}
+        <details class="excluded-section">
+            <summary>🔎 제외된 리소스 ({{.ExcludedCount}}개)</summary>
+            <p class="created-by">기본 리소스로 제외되어 수동 리소스 집계에서 빠진 리소스입니다. 제외 수가 많은 규칙이 실제 수동 리소스를 숨기고 있지 않은지 검토하세요.</p>
+            <table class="resources-table" style="margin-top: 15px;">
+                <thead>
+                    <tr>
+                        <th>규칙 카테고리</th>
+                        <th>패턴</th>
+                        <th style="text-align: right;">제외 수</th>
+                        <th style="text-align: right;">네임스페이스 수</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range $summary := .Exclusions}}
+                    <tr>
+                        <td class="resource-kind">{{$summary.Rule.Section}}</td>
+                        <td class="resource-name">{{$summary.Rule.Pattern}}</td>
+                        <td style="text-align: right;">{{$summary.Resources}}</td>
+                        <td style="text-align: right;">{{len $summary.Namespaces}}</td>
+                    </tr>
+                    {{end}}
+                </tbody>
+            </table>
+            <table class="resources-table" style="margin-top: 15px;">
+                <thead>
+                    <tr>
+                        <th>네임스페이스</th>
+                        <th>리소스</th>
+                        <th>규칙 카테고리</th>
+                        <th>패턴</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range $ns := .AllSortedNamespaces}}
+                    {{$result := index $.AllResults $ns}}
+                    {{range $resource := $result.ExcludedResourceList}}
+                    <tr>
+                        <td>{{$ns}}</td>
+                        <td class="resource-name">{{$resource.Identifier.Kind}}/{{$resource.Identifier.Name}}</td>
+                        {{with $resource.DecidedBy}}<td class="resource-kind">{{.Section}}</td><td class="created-by">{{.Pattern}}</td>{{else}}<td>-</td><td>-</td>{{end}}
+                    </tr>
+                    {{end}}
+                    {{end}}
+                </tbody>
+            </table>
+        </details>
+        {{end}}
+
         <div class="footer">
             Generated by argus | {{formatTime .StartTime}}
         </div>
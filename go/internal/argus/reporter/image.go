@@ -1,6 +1,7 @@
 package reporter
 
 import (
+	"context"
 	"fmt"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 	"os"
@@ -11,16 +12,18 @@ import (
 )
 
 type ImageReporter struct {
-	outputDir string
+	outputDir        string
+	fileNameTemplate string
 }
 
-func NewImageReporter(outputDir string) *ImageReporter {
+func NewImageReporter(outputDir, fileNameTemplate string) *ImageReporter {
 	return &ImageReporter{
-		outputDir: outputDir,
+		outputDir:        outputDir,
+		fileNameTemplate: fileNameTemplate,
 	}
 }
 
-func (r *ImageReporter) Generate(results map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+func (r *ImageReporter) Generate(ctx context.Context, results map[string]domain.AnalysisResult, run domain.RunInfo) error {
 	hasActionRequired := false
 	for _, result := range results {
 		if result.ManualResources > 0 {
@@ -34,17 +37,22 @@ func (r *ImageReporter) Generate(results map[string]domain.AnalysisResult, conte
 		return nil
 	}
 
-	htmlFile := filepath.Join(r.outputDir, fmt.Sprintf("%s.html", startTime.Format("20060102_150405")))
+	baseName := renderFileName(r.fileNameTemplate, run)
+	htmlFile := filepath.Join(r.outputDir, baseName+".html")
 
 	time.Sleep(100 * time.Millisecond)
 
-	imageFile := filepath.Join(r.outputDir, fmt.Sprintf("%s.png", startTime.Format("20060102_150405")))
+	imageFile := filepath.Join(r.outputDir, baseName+".png")
 
-	if err := r.convertWithWkhtmltoimage(htmlFile, imageFile); err == nil {
+	if err := r.convertWithWkhtmltoimage(ctx, htmlFile, imageFile); err == nil {
 		fmt.Printf("🖼️  이미지 생성 완료: %s\n", imageFile)
 		return nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	fmt.Printf("⚠️  wkhtmltoimage를 찾을 수 없습니다. 대체 방법을 시도합니다.\n")
 
 	if err := r.generateAlternativeInstructions(htmlFile); err != nil {
@@ -54,8 +62,8 @@ func (r *ImageReporter) Generate(results map[string]domain.AnalysisResult, conte
 	return nil
 }
 
-func (r *ImageReporter) convertWithWkhtmltoimage(htmlFile, imageFile string) error {
-	cmd := exec.Command("wkhtmltoimage", "--version")
+func (r *ImageReporter) convertWithWkhtmltoimage(ctx context.Context, htmlFile, imageFile string) error {
+	cmd := exec.CommandContext(ctx, "wkhtmltoimage", "--version")
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("wkhtmltoimage not found")
 	}
@@ -72,7 +80,7 @@ func (r *ImageReporter) convertWithWkhtmltoimage(htmlFile, imageFile string) err
 		imageFile,
 	}
 
-	cmd = exec.Command("wkhtmltoimage", args...)
+	cmd = exec.CommandContext(ctx, "wkhtmltoimage", args...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("failed to convert HTML to image: %w\nOutput: %s", err, string(output))
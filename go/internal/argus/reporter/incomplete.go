@@ -0,0 +1,18 @@
+package reporter
+
+import (
+	"sort"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func collectIncompleteNamespaces(results map[string]domain.AnalysisResult) []string {
+	var namespaces []string
+	for ns, result := range results {
+		if result.IsIncomplete() {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -1,10 +1,11 @@
 package reporter
 
 import (
+	"context"
+
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
-	"time"
 )
 
 type Reporter interface {
-	Generate(allResults map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error
+	Generate(ctx context.Context, allResults map[string]domain.AnalysisResult, run domain.RunInfo) error
 }
@@ -0,0 +1,148 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+type JSONReporter struct {
+	outputDir        string
+	fileNameTemplate string
+	configFile       string
+	configHash       string
+	showExcluded     bool
+}
+
+type JSONReport struct {
+	Metadata   JSONReportMetadata               `json:"metadata"`
+	Results    map[string]domain.AnalysisResult `json:"results"`
+	Comparison *domain.ScanComparison           `json:"comparison,omitempty"`
+}
+
+type JSONReportMetadata struct {
+	Context           string    `json:"context"`
+	Cluster           string    `json:"cluster"`
+	StartTime         time.Time `json:"startTime"`
+	DurationSeconds   float64   `json:"durationSeconds"`
+	ConfigFile        string    `json:"configFile"`
+	ConfigHash        string    `json:"configHash"`
+	Partial           bool      `json:"partial"`
+	SkippedNamespaces []string  `json:"skippedNamespaces,omitempty"`
+	BaselineFile      string    `json:"baselineFile,omitempty"`
+	// DiscoveryFailures는 discovery에 실패해 스캔하지 못한 API 그룹이다
+	DiscoveryFailures []domain.ResourceTypeFailure `json:"discoveryFailures,omitempty"`
+}
+
+func NewJSONReporter(outputDir, fileNameTemplate, configFile, configHash string) *JSONReporter {
+	return &JSONReporter{
+		outputDir:        outputDir,
+		fileNameTemplate: fileNameTemplate,
+		configFile:       configFile,
+		configHash:       configHash,
+	}
+}
+
+// SetShowExcluded는 제외된 리소스 목록(excludedResourceList)을 리포트에 기록할지 설정한다
+func (r *JSONReporter) SetShowExcluded(show bool) {
+	r.showExcluded = show
+}
+
+func (r *JSONReporter) Generate(ctx context.Context, results map[string]domain.AnalysisResult, run domain.RunInfo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(r.outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	data, err := r.generateJSONContent(results, run)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON report: %w", err)
+	}
+
+	filename := filepath.Join(r.outputDir, renderFileName(r.fileNameTemplate, run)+".json")
+
+	// 리소스 라벨/어노테이션에 민감한 값이 있을 수 있으므로 소유자만 읽을 수 있게 저장한다
+	if err := os.WriteFile(filename, data, 0600); err != nil {
+		return fmt.Errorf("failed to write JSON file: %w", err)
+	}
+
+	fmt.Printf("📄 JSON 리포트 생성: %s\n", filename)
+	return nil
+}
+
+func (r *JSONReporter) generateJSONContent(results map[string]domain.AnalysisResult, run domain.RunInfo) ([]byte, error) {
+	if results == nil {
+		results = map[string]domain.AnalysisResult{}
+	}
+
+	report := JSONReport{
+		Metadata: JSONReportMetadata{
+			Context:           run.Context,
+			Cluster:           run.Cluster,
+			StartTime:         run.StartTime,
+			DurationSeconds:   time.Since(run.StartTime).Seconds(),
+			ConfigFile:        r.configFile,
+			ConfigHash:        r.configHash,
+			Partial:           run.Partial,
+			SkippedNamespaces: run.SkippedNamespaces,
+			DiscoveryFailures: run.DiscoveryFailures,
+			BaselineFile:      run.BaselineFile,
+		},
+		Results:    withoutVolatileAnnotations(results, r.showExcluded),
+		Comparison: run.Comparison,
+	}
+
+	return json.MarshalIndent(report, "", "  ")
+}
+
+// withoutVolatileAnnotations는 매니페스트 내보내기와 같은 규칙으로 어노테이션을 제거한 결과 사본을 반환한다.
+// kubectl apply로 만든 Secret은 last-applied-configuration에 data가 평문으로 들어 있으므로 리포트에 남기지 않는다.
+// 제외된 리소스 목록은 includeExcluded일 때만 남긴다.
+func withoutVolatileAnnotations(results map[string]domain.AnalysisResult, includeExcluded bool) map[string]domain.AnalysisResult {
+	cleaned := make(map[string]domain.AnalysisResult, len(results))
+	for ns, result := range results {
+		result.ManualResourceList = stripVolatileAnnotations(result.ManualResourceList)
+		result.ArgoCDResourceList = stripVolatileAnnotations(result.ArgoCDResourceList)
+		result.OrphanedResourceList = stripVolatileAnnotations(result.OrphanedResourceList)
+		result.OtherManagedResourceList = stripVolatileAnnotations(result.OtherManagedResourceList)
+		if includeExcluded {
+			result.ExcludedResourceList = stripVolatileAnnotations(result.ExcludedResourceList)
+		} else {
+			result.ExcludedResourceList = nil
+		}
+		cleaned[ns] = result
+	}
+	return cleaned
+}
+
+func stripVolatileAnnotations(resources []domain.KubernetesResource) []domain.KubernetesResource {
+	if resources == nil {
+		return nil
+	}
+
+	stripped := make([]domain.KubernetesResource, len(resources))
+	for i, resource := range resources {
+		if resource.Annotations == nil {
+			stripped[i] = resource
+			continue
+		}
+		annotations := make(map[string]string, len(resource.Annotations))
+		for key, value := range resource.Annotations {
+			annotations[key] = value
+		}
+		for _, key := range volatileAnnotations {
+			delete(annotations, key)
+		}
+		resource.Annotations = annotations
+		stripped[i] = resource
+	}
+	return stripped
+}
@@ -0,0 +1,204 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func TestJSONReporter_Generate(t *testing.T) {
+	tmpDir := t.TempDir()
+	reporter := NewJSONReporter(tmpDir, "", "rules.yaml", "abc123")
+
+	results := map[string]domain.AnalysisResult{
+		"default": {
+			TotalResources:  3,
+			RootResources:   2,
+			ArgoCDManaged:   1,
+			ManualResources: 1,
+			ManualResourceList: []domain.KubernetesResource{
+				{
+					Identifier: domain.ResourceIdentifier{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Name:       "manual-config",
+						Namespace:  "default",
+					},
+					CreatedAt: "2024-01-15T14:30:45Z",
+					Labels:    map[string]string{"app": "test"},
+					Annotations: map[string]string{
+						"owner": "sre",
+						"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"c2VjcmV0"}}`,
+					},
+				},
+			},
+		},
+	}
+
+	startTime := time.Date(2024, 1, 15, 14, 30, 45, 0, time.UTC)
+	if err := reporter.Generate(context.Background(), results, domain.RunInfo{Context: "test-context", Cluster: "test-cluster", StartTime: startTime}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	filename := filepath.Join(tmpDir, "20240115_143045.json")
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("JSON 파일을 읽을 수 없습니다: %v", err)
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("JSON 리포트 권한 = %v, want 0600", info.Mode().Perm())
+	}
+
+	var report JSONReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+
+	if report.Metadata.Context != "test-context" {
+		t.Errorf("Metadata.Context = %v, want test-context", report.Metadata.Context)
+	}
+	if report.Metadata.Cluster != "test-cluster" {
+		t.Errorf("Metadata.Cluster = %v, want test-cluster", report.Metadata.Cluster)
+	}
+	if report.Metadata.ConfigHash != "abc123" {
+		t.Errorf("Metadata.ConfigHash = %v, want abc123", report.Metadata.ConfigHash)
+	}
+	if !report.Metadata.StartTime.Equal(startTime) {
+		t.Errorf("Metadata.StartTime = %v, want %v", report.Metadata.StartTime, startTime)
+	}
+
+	result, ok := report.Results["default"]
+	if !ok {
+		t.Fatal("default 네임스페이스 결과가 없습니다")
+	}
+	if result.ManualResources != 1 {
+		t.Errorf("ManualResources = %v, want 1", result.ManualResources)
+	}
+	if len(result.ManualResourceList) != 1 {
+		t.Fatalf("ManualResourceList 길이 = %v, want 1", len(result.ManualResourceList))
+	}
+
+	resource := result.ManualResourceList[0]
+	if resource.Identifier.Name != "manual-config" {
+		t.Errorf("Identifier.Name = %v, want manual-config", resource.Identifier.Name)
+	}
+	if resource.Labels["app"] != "test" {
+		t.Errorf("Labels[app] = %v, want test", resource.Labels["app"])
+	}
+	if resource.Annotations["owner"] != "sre" {
+		t.Errorf("Annotations[owner] = %v, want sre", resource.Annotations["owner"])
+	}
+	if _, ok := resource.Annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		t.Error("last-applied-configuration 어노테이션이 리포트에 포함되었습니다")
+	}
+	if _, ok := results["default"].ManualResourceList[0].Annotations["kubectl.kubernetes.io/last-applied-configuration"]; !ok {
+		t.Error("원본 결과의 어노테이션이 변경되었습니다")
+	}
+	if resource.CreatedAt != "2024-01-15T14:30:45Z" {
+		t.Errorf("CreatedAt = %v, want 2024-01-15T14:30:45Z", resource.CreatedAt)
+	}
+}
+
+func TestJSONReporter_EmptyResults(t *testing.T) {
+	reporter := &JSONReporter{}
+
+	data, err := reporter.generateJSONContent(nil, domain.RunInfo{Context: "ctx", Cluster: "cluster", StartTime: time.Now()})
+	if err != nil {
+		t.Fatalf("generateJSONContent() error = %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+
+	if _, ok := raw["results"].(map[string]interface{}); !ok {
+		t.Error("results는 null이 아닌 빈 객체여야 합니다")
+	}
+}
+
+func TestJSONReporter_PartialMetadata(t *testing.T) {
+	reporter := &JSONReporter{}
+	run := domain.RunInfo{
+		Context:           "ctx",
+		StartTime:         time.Now(),
+		Partial:           true,
+		SkippedNamespaces: []string{"ns-b", "ns-c"},
+	}
+
+	data, err := reporter.generateJSONContent(map[string]domain.AnalysisResult{"ns-a": {}}, run)
+	if err != nil {
+		t.Fatalf("generateJSONContent() error = %v", err)
+	}
+
+	var report JSONReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("JSON 파싱 실패: %v", err)
+	}
+
+	if !report.Metadata.Partial {
+		t.Error("Metadata.Partial = false, want true")
+	}
+	if len(report.Metadata.SkippedNamespaces) != 2 {
+		t.Errorf("Metadata.SkippedNamespaces = %v, want [ns-b ns-c]", report.Metadata.SkippedNamespaces)
+	}
+}
+
+func TestJSONReporter_ShowExcluded(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"default": {
+			TotalResources:   1,
+			ExcludedDefaults: 1,
+			ExcludedResourceList: []domain.KubernetesResource{
+				{
+					Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: "ConfigMap", Name: "kube-root-ca.crt", Namespace: "default"},
+					DecidedBy:  &domain.DecisionRule{Section: "exclusions.others", Pattern: "*/ConfigMap/kube-root-ca.crt"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		showExcluded bool
+		wantExcluded int
+	}{
+		{name: "기본값은 제외된 리소스 수만 기록", showExcluded: false, wantExcluded: 0},
+		{name: "--show-excluded", showExcluded: true, wantExcluded: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reporter := NewJSONReporter(t.TempDir(), "", "rules.yaml", "abc123")
+			reporter.SetShowExcluded(tt.showExcluded)
+
+			data, err := reporter.generateJSONContent(results, domain.RunInfo{StartTime: time.Now()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var report JSONReport
+			if err := json.Unmarshal(data, &report); err != nil {
+				t.Fatal(err)
+			}
+
+			result := report.Results["default"]
+			if result.ExcludedDefaults != 1 {
+				t.Errorf("ExcludedDefaults = %d, want 1", result.ExcludedDefaults)
+			}
+			if len(result.ExcludedResourceList) != tt.wantExcluded {
+				t.Errorf("ExcludedResourceList 길이 = %d, want %d", len(result.ExcludedResourceList), tt.wantExcluded)
+			}
+		})
+	}
+	if len(results["default"].ExcludedResourceList) != 1 {
+		t.Error("원본 결과의 제외된 리소스 목록이 변경되었습니다")
+	}
+}
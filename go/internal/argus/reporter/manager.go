@@ -0,0 +1,51 @@
+package reporter
+
+import (
+	"sort"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+type managerSummary struct {
+	Name       string
+	Resources  int
+	Namespaces []string
+}
+
+// summarizeManagers는 관리 도구별 리소스 수를 합산한다. ArgoCD를 먼저, 나머지는 이름순으로 정렬한다.
+func summarizeManagers(results map[string]domain.AnalysisResult) []managerSummary {
+	byManager := make(map[string]*managerSummary)
+	for ns, result := range results {
+		for manager, count := range result.ManagerCounts {
+			summary, ok := byManager[manager]
+			if !ok {
+				summary = &managerSummary{Name: manager}
+				byManager[manager] = summary
+			}
+			summary.Resources += count
+			summary.Namespaces = append(summary.Namespaces, ns)
+		}
+	}
+
+	summaries := make([]managerSummary, 0, len(byManager))
+	for _, summary := range byManager {
+		sort.Strings(summary.Namespaces)
+		summaries = append(summaries, *summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if (summaries[i].Name == domain.ManagerArgoCD) != (summaries[j].Name == domain.ManagerArgoCD) {
+			return summaries[i].Name == domain.ManagerArgoCD
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries
+}
+
+func countOtherManaged(results map[string]domain.AnalysisResult) int {
+	total := 0
+	for _, result := range results {
+		total += result.OtherManaged
+	}
+	return total
+}
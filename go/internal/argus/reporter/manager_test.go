@@ -0,0 +1,32 @@
+package reporter
+
+import (
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func TestSummarizeManagers(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"shop":     {ManagerCounts: map[string]int{"Helm": 3, domain.ManagerArgoCD: 2}},
+		"payments": {ManagerCounts: map[string]int{"Flux": 1, "Helm": 1}},
+		"empty":    {},
+	}
+
+	summaries := summarizeManagers(results)
+
+	want := []managerSummary{
+		{Name: domain.ManagerArgoCD, Resources: 2, Namespaces: []string{"shop"}},
+		{Name: "Flux", Resources: 1, Namespaces: []string{"payments"}},
+		{Name: "Helm", Resources: 4, Namespaces: []string{"payments", "shop"}},
+	}
+	if len(summaries) != len(want) {
+		t.Fatalf("summarizeManagers() = %+v, want %+v", summaries, want)
+	}
+	for i := range want {
+		got := summaries[i]
+		if got.Name != want[i].Name || got.Resources != want[i].Resources || !equalStrings(got.Namespaces, want[i].Namespaces) {
+			t.Errorf("summaries[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,413 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SecretMode는 Secret을 내보내는 방식이다
+type SecretMode string
+
+const (
+	// SecretPlain은 Secret을 그대로(평문 base64) 내보낸다
+	SecretPlain SecretMode = "plain"
+	// SecretSealed는 값 대신 kubeseal로 채울 자리를 표시한 SealedSecret을 만든다
+	SecretSealed SecretMode = "sealed-secret"
+	// SecretExternal은 원격 키를 채울 자리를 표시한 ExternalSecret을 만든다
+	SecretExternal SecretMode = "external-secret"
+	// SecretSkip은 Secret을 내보내지 않는다
+	SecretSkip SecretMode = "skip"
+)
+
+func ParseSecretMode(value string) (SecretMode, error) {
+	switch SecretMode(value) {
+	case SecretPlain, SecretSealed, SecretExternal, SecretSkip:
+		return SecretMode(value), nil
+	}
+	return "", fmt.Errorf("지원하지 않는 Secret 내보내기 방식: %s (plain, sealed-secret, external-secret, skip 중 하나)", value)
+}
+
+// clusterScopeDir은 클러스터 범위 리소스를 내보내는 디렉토리 이름이다
+const clusterScopeDir = "_cluster"
+
+// 서버가 채우거나 클러스터마다 달라지는 메타데이터 필드
+var volatileMetadataFields = []string{
+	"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp",
+	"deletionGracePeriodSeconds", "selfLink", "managedFields", "ownerReferences",
+}
+
+// 도구나 컨트롤러가 기록하는 어노테이션
+var volatileAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.beta.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/selected-node",
+}
+
+// ManifestExporter는 수동 리소스를 Git에 커밋할 수 있도록 서버 관리 필드를 제거한 YAML로 내보낸다.
+// <출력 디렉토리>/<파일명>-manifests/<네임스페이스>/<kind>-<이름>.yaml 구조로 저장한다.
+type ManifestExporter struct {
+	outputDir        string
+	fileNameTemplate string
+	kustomization    bool
+	secretMode       SecretMode
+}
+
+func NewManifestExporter(outputDir, fileNameTemplate string) *ManifestExporter {
+	return &ManifestExporter{
+		outputDir:        outputDir,
+		fileNameTemplate: fileNameTemplate,
+		secretMode:       SecretSealed,
+	}
+}
+
+// SetKustomization은 네임스페이스 디렉토리마다 kustomization.yaml을 함께 만들지 설정한다
+func (r *ManifestExporter) SetKustomization(enabled bool) {
+	r.kustomization = enabled
+}
+
+func (r *ManifestExporter) SetSecretMode(mode SecretMode) {
+	r.secretMode = mode
+}
+
+func (r *ManifestExporter) Generate(ctx context.Context, results map[string]domain.AnalysisResult, run domain.RunInfo) error {
+	root := filepath.Join(r.outputDir, renderFileName(r.fileNameTemplate, run)+"-manifests")
+	files, secretFiles, err := r.render(results)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		fmt.Printf("✅ 내보낼 수동 리소스가 없어 매니페스트를 생성하지 않습니다.\n")
+		return nil
+	}
+
+	for _, path := range sortedFilePaths(files) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		filename := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return fmt.Errorf("failed to create manifest directory: %w", err)
+		}
+		// 평문 Secret은 Git에 커밋되기 전까지 소유자만 읽을 수 있게 한다
+		perm := os.FileMode(0644)
+		if secretFiles[path] {
+			perm = 0600
+		}
+		if err := os.WriteFile(filename, files[path], perm); err != nil {
+			return fmt.Errorf("failed to write manifest: %w", err)
+		}
+	}
+
+	fmt.Printf("📦 매니페스트 내보내기: %s (%d개 파일)\n", root, len(files))
+	if r.secretMode == SecretPlain && r.hasSecrets(results) {
+		fmt.Printf("⚠️  Secret이 평문으로 포함되어 있습니다. Git에 커밋하기 전에 -export-secrets 옵션을 확인하세요.\n")
+	}
+	return nil
+}
+
+// render는 내보낼 파일의 상대 경로와 내용, 그리고 평문 Secret이 들어 있는 파일 경로를 반환한다
+func (r *ManifestExporter) render(results map[string]domain.AnalysisResult) (map[string][]byte, map[string]bool, error) {
+	files := make(map[string][]byte)
+	secretFiles := make(map[string]bool)
+	resourcesByDir := make(map[string][]string)
+
+	for _, ns := range sortedResultKeys(results) {
+		resources := append([]domain.KubernetesResource(nil), results[ns].ManualResourceList...)
+		sortResources(resources)
+
+		for _, resource := range resources {
+			if resource.Object == nil {
+				continue
+			}
+			manifest := r.exportObject(resource.Object)
+			if manifest == nil {
+				continue
+			}
+
+			dir := manifestDir(resource.Identifier.Namespace)
+			path := manifestPath(dir, manifest, files)
+			data, err := marshalManifest(manifest.Object)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal manifest %s: %w", path, err)
+			}
+			files[path] = data
+			if manifest.GetKind() == "Secret" {
+				secretFiles[path] = true
+			}
+			resourcesByDir[dir] = append(resourcesByDir[dir], filepath.Base(path))
+		}
+	}
+
+	if r.kustomization {
+		for dir, resources := range resourcesByDir {
+			data, err := marshalManifest(kustomization(dir, resources))
+			if err != nil {
+				return nil, nil, err
+			}
+			files[filepath.Join(dir, "kustomization.yaml")] = data
+		}
+	}
+	return files, secretFiles, nil
+}
+
+func (r *ManifestExporter) exportObject(obj map[string]interface{}) *unstructured.Unstructured {
+	manifest := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(obj)}
+	sanitizeManifest(manifest)
+
+	if manifest.GetKind() != "Secret" || manifest.GetAPIVersion() != "v1" {
+		return manifest
+	}
+	switch r.secretMode {
+	case SecretSkip:
+		return nil
+	case SecretSealed:
+		return sealedSecretPlaceholder(manifest)
+	case SecretExternal:
+		return externalSecretPlaceholder(manifest)
+	}
+	return manifest
+}
+
+func (r *ManifestExporter) hasSecrets(results map[string]domain.AnalysisResult) bool {
+	for _, result := range results {
+		for _, resource := range result.ManualResourceList {
+			if resource.Identifier.Kind == "Secret" && resource.Object != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sanitizeManifest는 status와 서버가 관리하는 필드를 제거해 다른 클러스터에도 적용할 수 있는 형태로 만든다
+func sanitizeManifest(manifest *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(manifest.Object, "status")
+	for _, field := range volatileMetadataFields {
+		unstructured.RemoveNestedField(manifest.Object, "metadata", field)
+	}
+
+	annotations := manifest.GetAnnotations()
+	for _, key := range volatileAnnotations {
+		delete(annotations, key)
+	}
+	manifest.SetAnnotations(annotations)
+	for _, field := range []string{"labels", "annotations"} {
+		if values, _, _ := unstructured.NestedMap(manifest.Object, "metadata", field); len(values) == 0 {
+			unstructured.RemoveNestedField(manifest.Object, "metadata", field)
+		}
+	}
+
+	switch manifest.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Service"}:
+		// headless 서비스가 아니면 ClusterIP는 클러스터가 할당한다
+		if clusterIP, _, _ := unstructured.NestedString(manifest.Object, "spec", "clusterIP"); clusterIP != "None" {
+			unstructured.RemoveNestedField(manifest.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(manifest.Object, "spec", "clusterIPs")
+		}
+	case schema.GroupKind{Kind: "PersistentVolumeClaim"}:
+		unstructured.RemoveNestedField(manifest.Object, "spec", "volumeName")
+	case schema.GroupKind{Group: "batch", Kind: "Job"}:
+		// 자동 생성된 selector와 controller-uid 라벨은 다시 적용하면 충돌한다
+		if manual, _, _ := unstructured.NestedBool(manifest.Object, "spec", "manualSelector"); !manual {
+			unstructured.RemoveNestedField(manifest.Object, "spec", "selector")
+			for _, label := range []string{"controller-uid", "batch.kubernetes.io/controller-uid", "job-name", "batch.kubernetes.io/job-name"} {
+				unstructured.RemoveNestedField(manifest.Object, "spec", "template", "metadata", "labels", label)
+			}
+		}
+	}
+}
+
+func sealedSecretPlaceholder(secret *unstructured.Unstructured) *unstructured.Unstructured {
+	encryptedData := map[string]interface{}{}
+	for _, key := range secretKeys(secret) {
+		encryptedData[key] = "<kubeseal로 암호화한 값>"
+	}
+
+	template := map[string]interface{}{"metadata": secretTemplateMetadata(secret)}
+	if secretType, _, _ := unstructured.NestedString(secret.Object, "type"); secretType != "" {
+		template["type"] = secretType
+	}
+
+	placeholder := newPlaceholder("bitnami.com/v1alpha1", "SealedSecret", secret)
+	placeholder.Object["spec"] = map[string]interface{}{
+		"encryptedData": encryptedData,
+		"template":      template,
+	}
+	return placeholder
+}
+
+func externalSecretPlaceholder(secret *unstructured.Unstructured) *unstructured.Unstructured {
+	var data []interface{}
+	for _, key := range secretKeys(secret) {
+		data = append(data, map[string]interface{}{
+			"secretKey": key,
+			"remoteRef": map[string]interface{}{"key": "<원격 키>", "property": key},
+		})
+	}
+
+	template := map[string]interface{}{"metadata": secretTemplateMetadata(secret)}
+	if secretType, _, _ := unstructured.NestedString(secret.Object, "type"); secretType != "" {
+		template["type"] = secretType
+	}
+
+	placeholder := newPlaceholder("external-secrets.io/v1beta1", "ExternalSecret", secret)
+	placeholder.Object["spec"] = map[string]interface{}{
+		"refreshInterval": "1h",
+		"secretStoreRef":  map[string]interface{}{"name": "<SecretStore 이름>", "kind": "SecretStore"},
+		"target":          map[string]interface{}{"name": secret.GetName(), "template": template},
+		"data":            data,
+	}
+	return placeholder
+}
+
+func newPlaceholder(apiVersion, kind string, secret *unstructured.Unstructured) *unstructured.Unstructured {
+	placeholder := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	placeholder.SetAPIVersion(apiVersion)
+	placeholder.SetKind(kind)
+	placeholder.SetName(secret.GetName())
+	placeholder.SetNamespace(secret.GetNamespace())
+	return placeholder
+}
+
+// secretTemplateMetadata는 생성될 Secret에 그대로 붙일 라벨/어노테이션이다
+func secretTemplateMetadata(secret *unstructured.Unstructured) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if labels := secret.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = stringMapToInterface(labels)
+	}
+	if annotations := secret.GetAnnotations(); len(annotations) > 0 {
+		metadata["annotations"] = stringMapToInterface(annotations)
+	}
+	return metadata
+}
+
+func secretKeys(secret *unstructured.Unstructured) []string {
+	keys := map[string]bool{}
+	for _, field := range []string{"data", "stringData"} {
+		values, _, _ := unstructured.NestedMap(secret.Object, field)
+		for key := range values {
+			keys[key] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func stringMapToInterface(values map[string]string) map[string]interface{} {
+	converted := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		converted[key] = value
+	}
+	return converted
+}
+
+func kustomization(dir string, resources []string) map[string]interface{} {
+	sort.Strings(resources)
+	items := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		items = append(items, resource)
+	}
+
+	k := map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  items,
+	}
+	if dir != clusterScopeDir {
+		k["namespace"] = dir
+	}
+	return k
+}
+
+func manifestDir(namespace string) string {
+	if namespace == domain.ClusterScope || namespace == "" {
+		return clusterScopeDir
+	}
+	return namespace
+}
+
+// manifestPath는 <디렉토리>/<kind>-<이름>.yaml을 만들고, 같은 Kind 이름이 다른 API 그룹에 있어 겹치면 그룹을 덧붙인다.
+// 이름을 정리하면서 서로 다른 리소스가 같은 파일명이 될 수도 있으므로 그래도 겹치면 번호를 붙인다.
+func manifestPath(dir string, manifest *unstructured.Unstructured, existing map[string][]byte) string {
+	kind := strings.ToLower(manifest.GetKind())
+	name := sanitizeFileNamePart(manifest.GetName())
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", kind, name))
+	if _, taken := existing[path]; !taken {
+		return path
+	}
+	if group := manifest.GroupVersionKind().Group; group != "" {
+		kind = kind + "." + group
+		path = filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", kind, name))
+		if _, taken := existing[path]; !taken {
+			return path
+		}
+	}
+	for i := 2; ; i++ {
+		path = filepath.Join(dir, fmt.Sprintf("%s-%s-%d.yaml", kind, name, i))
+		if _, taken := existing[path]; !taken {
+			return path
+		}
+	}
+}
+
+func marshalManifest(obj map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(obj); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sortedResultKeys(results map[string]domain.AnalysisResult) []string {
+	keys := make([]string, 0, len(results))
+	for key := range results {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedFilePaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func sortResources(resources []domain.KubernetesResource) {
+	sort.Slice(resources, func(i, j int) bool {
+		a, b := resources[i].Identifier, resources[j].Identifier
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Name < b.Name
+	})
+}
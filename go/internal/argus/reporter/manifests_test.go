@@ -0,0 +1,324 @@
+package reporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func manualResource(obj map[string]interface{}) domain.KubernetesResource {
+	u := &unstructured.Unstructured{Object: obj}
+	namespace := u.GetNamespace()
+	if namespace == "" {
+		namespace = domain.ClusterScope
+	}
+	return domain.KubernetesResource{
+		Identifier: domain.ResourceIdentifier{
+			APIVersion: u.GetAPIVersion(),
+			Kind:       u.GetKind(),
+			Name:       u.GetName(),
+			Namespace:  namespace,
+		},
+		Object: obj,
+	}
+}
+
+func readManifest(t *testing.T, path string) map[string]interface{} {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("매니페스트를 읽을 수 없습니다: %v", err)
+	}
+	var manifest map[string]interface{}
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("YAML 파싱 실패: %v", err)
+	}
+	return manifest
+}
+
+func TestManifestExporter_Generate(t *testing.T) {
+	tmpDir := t.TempDir()
+	exporter := NewManifestExporter(tmpDir, "")
+	exporter.SetKustomization(true)
+
+	configMap := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":              "manual-config",
+			"namespace":         "default",
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2024-01-15T14:30:45Z",
+			"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+			"labels": map[string]interface{}{"app": "test"},
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}
+	results := map[string]domain.AnalysisResult{
+		"default": {
+			ManualResourceList: []domain.KubernetesResource{
+				manualResource(configMap),
+				manualResource(map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Service",
+					"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+					"spec": map[string]interface{}{
+						"clusterIP":  "10.0.0.1",
+						"clusterIPs": []interface{}{"10.0.0.1"},
+						"ports":      []interface{}{map[string]interface{}{"port": int64(80)}},
+					},
+					"status": map[string]interface{}{"loadBalancer": map[string]interface{}{}},
+				}),
+			},
+		},
+		domain.ClusterScope: {
+			ManualResourceList: []domain.KubernetesResource{
+				manualResource(map[string]interface{}{
+					"apiVersion": "rbac.authorization.k8s.io/v1",
+					"kind":       "ClusterRole",
+					"metadata":   map[string]interface{}{"name": "reader"},
+				}),
+			},
+		},
+	}
+
+	startTime := time.Date(2024, 1, 15, 14, 30, 45, 0, time.UTC)
+	if err := exporter.Generate(context.Background(), results, domain.RunInfo{StartTime: startTime}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	root := filepath.Join(tmpDir, "20240115_143045-manifests")
+	got := readManifest(t, filepath.Join(root, "default", "configmap-manual-config.yaml"))
+	want := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "manual-config",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "test"},
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConfigMap = %v, want %v", got, want)
+	}
+	if _, ok := configMap["metadata"].(map[string]interface{})["uid"]; !ok {
+		t.Error("원본 객체가 변경되었습니다")
+	}
+
+	service := readManifest(t, filepath.Join(root, "default", "service-web.yaml"))
+	if _, ok := service["status"]; ok {
+		t.Error("status가 제거되지 않았습니다")
+	}
+	if spec := service["spec"].(map[string]interface{}); spec["clusterIP"] != nil || spec["clusterIPs"] != nil {
+		t.Errorf("clusterIP가 제거되지 않았습니다: %v", spec)
+	}
+
+	if _, err := os.Stat(filepath.Join(root, clusterScopeDir, "clusterrole-reader.yaml")); err != nil {
+		t.Errorf("클러스터 범위 리소스가 %s 디렉토리에 없습니다: %v", clusterScopeDir, err)
+	}
+
+	kustomization := readManifest(t, filepath.Join(root, "default", "kustomization.yaml"))
+	if kustomization["namespace"] != "default" {
+		t.Errorf("kustomization namespace = %v, want default", kustomization["namespace"])
+	}
+	wantResources := []interface{}{"configmap-manual-config.yaml", "service-web.yaml"}
+	if !reflect.DeepEqual(kustomization["resources"], wantResources) {
+		t.Errorf("kustomization resources = %v, want %v", kustomization["resources"], wantResources)
+	}
+}
+
+func TestManifestExporter_SecretMode(t *testing.T) {
+	secret := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"type":       "Opaque",
+		"metadata": map[string]interface{}{
+			"name":      "db",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "db"},
+		},
+		"data": map[string]interface{}{"password": "c2VjcmV0", "user": "YWRtaW4="},
+	}
+
+	tests := []struct {
+		name     string
+		mode     SecretMode
+		wantFile string
+		wantKind string
+		wantSpec string
+	}{
+		{name: "평문", mode: SecretPlain, wantFile: "secret-db.yaml", wantKind: "Secret"},
+		{name: "SealedSecret", mode: SecretSealed, wantFile: "sealedsecret-db.yaml", wantKind: "SealedSecret", wantSpec: "encryptedData"},
+		{name: "ExternalSecret", mode: SecretExternal, wantFile: "externalsecret-db.yaml", wantKind: "ExternalSecret", wantSpec: "data"},
+		{name: "건너뛰기", mode: SecretSkip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := NewManifestExporter(t.TempDir(), "")
+			exporter.SetSecretMode(tt.mode)
+
+			results := map[string]domain.AnalysisResult{
+				"default": {ManualResourceList: []domain.KubernetesResource{manualResource(secret)}},
+			}
+			files, _, err := exporter.render(results)
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+
+			if tt.wantFile == "" {
+				if len(files) != 0 {
+					t.Errorf("Secret이 내보내졌습니다: %v", sortedFilePaths(files))
+				}
+				return
+			}
+			data, ok := files[filepath.Join("default", tt.wantFile)]
+			if !ok {
+				t.Fatalf("%s가 없습니다: %v", tt.wantFile, sortedFilePaths(files))
+			}
+
+			var manifest map[string]interface{}
+			if err := yaml.Unmarshal(data, &manifest); err != nil {
+				t.Fatalf("YAML 파싱 실패: %v", err)
+			}
+			if manifest["kind"] != tt.wantKind {
+				t.Errorf("kind = %v, want %v", manifest["kind"], tt.wantKind)
+			}
+			if tt.wantSpec == "" {
+				return
+			}
+			if _, ok := manifest["data"]; ok {
+				t.Error("플레이스홀더에 Secret 값이 포함되었습니다")
+			}
+			spec := manifest["spec"].(map[string]interface{})
+			if _, ok := spec[tt.wantSpec]; !ok {
+				t.Errorf("spec.%s가 없습니다: %v", tt.wantSpec, spec)
+			}
+		})
+	}
+}
+
+func TestSanitizeManifest_Job(t *testing.T) {
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": "migrate", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"controller-uid": "abc"}},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": map[string]interface{}{
+					"app":                                "migrate",
+					"controller-uid":                     "abc",
+					"batch.kubernetes.io/controller-uid": "abc",
+					"job-name":                           "migrate",
+				}},
+			},
+		},
+	}}
+
+	sanitizeManifest(job)
+
+	if _, found, _ := unstructured.NestedMap(job.Object, "spec", "selector"); found {
+		t.Error("Job selector가 제거되지 않았습니다")
+	}
+	labels, _, _ := unstructured.NestedStringMap(job.Object, "spec", "template", "metadata", "labels")
+	if want := map[string]string{"app": "migrate"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("template labels = %v, want %v", labels, want)
+	}
+}
+
+func TestManifestPath_KindCollision(t *testing.T) {
+	existing := map[string][]byte{}
+	first := &unstructured.Unstructured{}
+	first.SetAPIVersion("networking.k8s.io/v1")
+	first.SetKind("Gateway")
+	first.SetName("main")
+	second := first.DeepCopy()
+	second.SetAPIVersion("networking.istio.io/v1")
+
+	path := manifestPath("default", first, existing)
+	existing[path] = nil
+	if want := filepath.Join("default", "gateway-main.yaml"); path != want {
+		t.Errorf("manifestPath() = %v, want %v", path, want)
+	}
+	path = manifestPath("default", second, existing)
+	existing[path] = nil
+	if want := filepath.Join("default", "gateway.networking.istio.io-main.yaml"); path != want {
+		t.Errorf("manifestPath() = %v, want %v", path, want)
+	}
+	if got, want := manifestPath("default", second, existing), filepath.Join("default", "gateway.networking.istio.io-main-2.yaml"); got != want {
+		t.Errorf("두 번째 충돌 manifestPath() = %v, want %v", got, want)
+	}
+}
+
+func TestParseSecretMode(t *testing.T) {
+	if _, err := ParseSecretMode("vault"); err == nil {
+		t.Error("ParseSecretMode(vault) error = nil, want error")
+	}
+	if mode, err := ParseSecretMode("sealed-secret"); err != nil || mode != SecretSealed {
+		t.Errorf("ParseSecretMode(sealed-secret) = %v, %v", mode, err)
+	}
+}
+
+func TestManifestExporter_PlainSecretPermission(t *testing.T) {
+	tmpDir := t.TempDir()
+	exporter := NewManifestExporter(tmpDir, "")
+	exporter.SetSecretMode(SecretPlain)
+
+	results := map[string]domain.AnalysisResult{
+		"default": {ManualResourceList: []domain.KubernetesResource{
+			manualResource(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "db", "namespace": "default"},
+				"data":       map[string]interface{}{"password": "c2VjcmV0"},
+			}),
+			manualResource(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "settings", "namespace": "default"},
+			}),
+		}},
+	}
+
+	startTime := time.Date(2024, 1, 15, 14, 30, 45, 0, time.UTC)
+	if err := exporter.Generate(context.Background(), results, domain.RunInfo{StartTime: startTime}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	root := filepath.Join(tmpDir, "20240115_143045-manifests", "default")
+	tests := []struct {
+		file string
+		want os.FileMode
+	}{
+		{file: "secret-db.yaml", want: 0600},
+		{file: "configmap-settings.yaml", want: 0644},
+	}
+	for _, tt := range tests {
+		info, err := os.Stat(filepath.Join(root, tt.file))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.file, err)
+		}
+		if got := info.Mode().Perm(); got != tt.want {
+			t.Errorf("%s 권한 = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestNewManifestExporter_DefaultSecretMode(t *testing.T) {
+	if got := NewManifestExporter("", "").secretMode; got != SecretSealed {
+		t.Errorf("기본 Secret 내보내기 방식 = %v, want %v", got, SecretSealed)
+	}
+}
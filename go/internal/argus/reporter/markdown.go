@@ -1,30 +1,49 @@
 package reporter
 
 import (
+	"context"
 	"fmt"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 )
 
 type MarkdownReporter struct {
-	reportDir string
+	reportDir        string
+	fileNameTemplate string
+	showRules        bool
+	showExcluded     bool
 }
 
-func NewMarkdownReporter(reportDir string) *MarkdownReporter {
-	return &MarkdownReporter{reportDir: reportDir}
+func NewMarkdownReporter(reportDir, fileNameTemplate string) *MarkdownReporter {
+	return &MarkdownReporter{reportDir: reportDir, fileNameTemplate: fileNameTemplate}
 }
 
-func (r *MarkdownReporter) Generate(allResults map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+// SetShowExcluded는 제외된 리소스 감사 섹션을 추가할지 설정한다
+func (r *MarkdownReporter) SetShowExcluded(show bool) {
+	r.showExcluded = show
+}
+
+// SetShowDecisionRules는 리소스 목록에 분류를 결정한 규칙 열을 추가할지 설정한다
+func (r *MarkdownReporter) SetShowDecisionRules(show bool) {
+	r.showRules = show
+}
+
+func (r *MarkdownReporter) Generate(ctx context.Context, allResults map[string]domain.AnalysisResult, run domain.RunInfo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if err := os.MkdirAll(r.reportDir, 0755); err != nil {
 		return fmt.Errorf("보고서 디렉토리 생성 실패: %w", err)
 	}
 
-	content := r.generateMarkdownContent(allResults, context, cluster, startTime)
+	content := r.generateMarkdownContent(allResults, run)
 
-	fileName := fmt.Sprintf("%s/%s.md", r.reportDir, startTime.Format("20060102_150405"))
+	fileName := filepath.Join(r.reportDir, renderFileName(r.fileNameTemplate, run)+".md")
 
 	if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
 		return fmt.Errorf("보고서 파일 저장 실패: %w", err)
@@ -34,17 +53,28 @@ func (r *MarkdownReporter) Generate(allResults map[string]domain.AnalysisResult,
 	return nil
 }
 
-func (r *MarkdownReporter) generateMarkdownContent(allResults map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) string {
+func (r *MarkdownReporter) generateMarkdownContent(allResults map[string]domain.AnalysisResult, run domain.RunInfo) string {
 	var sb strings.Builder
-	elapsed := time.Since(startTime)
+	elapsed := time.Since(run.StartTime)
 
 	sb.WriteString("# Argus 분석 리포트\n\n")
 
+	if run.Partial {
+		sb.WriteString(fmt.Sprintf("> ⚠️ **부분 리포트**: 스캔이 중단되어 %d개 네임스페이스가 분석되지 않았습니다.\n\n", len(run.SkippedNamespaces)))
+	}
+
 	sb.WriteString("## 실행 정보\n\n")
-	sb.WriteString(fmt.Sprintf("- **생성 시간**: %s\n", startTime.Format("2006-01-02 15:04:05")))
-	sb.WriteString(fmt.Sprintf("- **컨텍스트**: %s\n", context))
-	sb.WriteString(fmt.Sprintf("- **클러스터**: %s\n", cluster))
-	sb.WriteString(fmt.Sprintf("- **실행 시간**: %.2f초\n\n", elapsed.Seconds()))
+	sb.WriteString(fmt.Sprintf("- **생성 시간**: %s\n", run.StartTime.Format("2006-01-02 15:04:05")))
+	sb.WriteString(fmt.Sprintf("- **컨텍스트**: %s\n", run.Context))
+	sb.WriteString(fmt.Sprintf("- **클러스터**: %s\n", run.Cluster))
+	sb.WriteString(fmt.Sprintf("- **실행 시간**: %.2f초\n", elapsed.Seconds()))
+	if run.Partial {
+		sb.WriteString(fmt.Sprintf("- **미완료 네임스페이스**: %s\n", strings.Join(run.SkippedNamespaces, ", ")))
+	}
+	if run.BaselineFile != "" {
+		sb.WriteString(fmt.Sprintf("- **베이스라인**: %s (수용된 수동 리소스 %d개 숨김)\n", run.BaselineFile, countBaselineSuppressed(allResults)))
+	}
+	sb.WriteString("\n")
 
 	var sortedNamespaces []string
 	for ns := range allResults {
@@ -64,6 +94,21 @@ func (r *MarkdownReporter) generateMarkdownContent(allResults map[string]domain.
 
 	r.writeSummaryTable(&sb, allResults, sortedNamespaces)
 
+	r.writeManagerBreakdown(&sb, allResults)
+
+	r.writeClusterScope(&sb, allResults)
+
+	r.writeSinceLastScan(&sb, run.Comparison)
+
+	incompleteNamespaces := collectIncompleteNamespaces(allResults)
+	r.writeIncompleteScans(&sb, allResults, incompleteNamespaces)
+
+	r.writeBaselineReview(&sb, allResults)
+
+	r.writeOrphanedGitOps(&sb, allResults, sortedNamespaces)
+
+	r.writeApplicationBreakdown(&sb, allResults)
+
 	if len(unmanagedNamespaces) > 0 {
 		sb.WriteString("## ArgoCD 미관리 네임스페이스\n\n")
 		for _, ns := range unmanagedNamespaces {
@@ -71,6 +116,9 @@ func (r *MarkdownReporter) generateMarkdownContent(allResults map[string]domain.
 			sb.WriteString(fmt.Sprintf("- **%s**: %d개 수동 리소스\n", ns, result.ManualResources))
 		}
 		sb.WriteString("\n")
+	} else if len(incompleteNamespaces) > 0 {
+		sb.WriteString("## ⚠️ 수동 리소스 없음 (불완전한 스캔)\n\n")
+		sb.WriteString("발견된 수동 리소스는 없지만 일부 리소스 타입을 검사하지 못했습니다.\n\n")
 	} else {
 		sb.WriteString("## ✅ 모든 네임스페이스가 완전히 관리됨\n\n")
 		sb.WriteString("모든 리소스가 ArgoCD를 통해 관리되고 있습니다!\n\n")
@@ -93,23 +141,29 @@ func (r *MarkdownReporter) generateMarkdownContent(allResults map[string]domain.
 		sb.WriteString("### ArgoCD 미관리 네임스페이스\n")
 		for _, ns := range unmanagedNamespaces {
 			result := allResults[ns]
-			sb.WriteString(fmt.Sprintf("- [%s](#%s) - %d개 수동 리소스\n", ns, ns, result.ManualResources))
+			sb.WriteString(fmt.Sprintf("- [%s](#%s) - %d개 수동 리소스\n", ns, markdownAnchor(ns), result.ManualResources))
 		}
 		sb.WriteString("\n")
 	}
 
 	r.writeManualResourceDetails(&sb, allResults, sortedNamespaces)
 
+	if r.showExcluded {
+		r.writeExcludedResources(&sb, allResults, sortedNamespaces)
+	}
+
 	return sb.String()
 }
 
 func (r *MarkdownReporter) writeSummaryTable(sb *strings.Builder, allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
 	sb.WriteString("## 최종 결과 요약\n\n")
-	sb.WriteString("| 네임스페이스 | ArgoCD 관리 | 전체 리소스 | 최상위 리소스 | ArgoCD 관리 중 | 수동 생성 | 기본 리소스 (제외) |\n")
-	sb.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+	sb.WriteString("| 네임스페이스 | ArgoCD 관리 | 전체 리소스 | 최상위 리소스 | ArgoCD 관리 중 | 기타 도구 관리 | 고아 GitOps | 수동 생성 | 기본 리소스 (제외) |\n")
+	sb.WriteString("| --- | --- | --- | --- | --- | --- | --- | --- | --- |\n")
 
 	totalManual := 0
 	totalArgoCD := 0
+	totalOther := 0
+	totalOrphaned := 0
 	totalResources := 0
 	totalRootResources := 0
 	totalExcluded := 0
@@ -128,32 +182,219 @@ func (r *MarkdownReporter) writeSummaryTable(sb *strings.Builder, allResults map
 			argoCDManaged = "❌"
 		}
 
-		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d | %d | %d | %d |\n",
+		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d | %d | %d | %d | %d | %d |\n",
 			namespace,
 			argoCDManaged,
 			result.TotalResources,
 			result.RootResources,
 			result.ArgoCDManaged,
+			result.OtherManaged,
+			result.OrphanedGitOps,
 			result.ManualResources,
 			result.ExcludedDefaults,
 		))
 
 		totalManual += result.ManualResources
 		totalArgoCD += result.ArgoCDManaged
+		totalOther += result.OtherManaged
+		totalOrphaned += result.OrphanedGitOps
 		totalResources += result.TotalResources
 		totalRootResources += result.RootResources
 		totalExcluded += result.ExcludedDefaults
 	}
 
-	sb.WriteString(fmt.Sprintf("| **총계** | - | **%d** | **%d** | **%d** | **%d** | **%d** |\n\n",
+	sb.WriteString(fmt.Sprintf("| **총계** | - | **%d** | **%d** | **%d** | **%d** | **%d** | **%d** | **%d** |\n\n",
 		totalResources,
 		totalRootResources,
 		totalArgoCD,
+		totalOther,
+		totalOrphaned,
 		totalManual,
 		totalExcluded,
 	))
 }
 
+func (r *MarkdownReporter) writeManagerBreakdown(sb *strings.Builder, allResults map[string]domain.AnalysisResult) {
+	summaries := summarizeManagers(allResults)
+	if len(summaries) == 0 {
+		return
+	}
+
+	sb.WriteString("## 🛠️ 관리 도구별 리소스\n\n")
+	sb.WriteString("| 관리 도구 | 리소스 수 | 네임스페이스 |\n")
+	sb.WriteString("| --- | --- | --- |\n")
+	for _, summary := range summaries {
+		sb.WriteString(fmt.Sprintf("| %s | %d | %s |\n", summary.Name, summary.Resources, strings.Join(summary.Namespaces, ", ")))
+	}
+	sb.WriteString("\n")
+}
+
+func (r *MarkdownReporter) writeClusterScope(sb *strings.Builder, allResults map[string]domain.AnalysisResult) {
+	result, ok := clusterScopeResult(allResults)
+	if !ok {
+		return
+	}
+
+	sb.WriteString("## 🌐 클러스터 범위 리소스\n\n")
+	sb.WriteString(fmt.Sprintf("- **전체 리소스**: %d개\n", result.TotalResources))
+	sb.WriteString(fmt.Sprintf("- **ArgoCD 관리**: %d개\n", result.ArgoCDManaged))
+	sb.WriteString(fmt.Sprintf("- **기타 도구 관리**: %d개\n", result.OtherManaged))
+	sb.WriteString(fmt.Sprintf("- **수동 생성**: %d개\n", result.ManualResources))
+	sb.WriteString(fmt.Sprintf("- **기본 리소스 (제외)**: %d개\n\n", result.ExcludedDefaults))
+
+	counts := manualKindCounts(result)
+	if len(counts) == 0 {
+		return
+	}
+	sb.WriteString("| Kind | 수동 리소스 |\n")
+	sb.WriteString("| --- | --- |\n")
+	for _, kc := range counts {
+		sb.WriteString(fmt.Sprintf("| %s | %d |\n", kc.Kind, kc.Count))
+	}
+	sb.WriteString(fmt.Sprintf("\n상세 목록은 [%s](#%s) 항목을 확인하세요.\n\n", domain.ClusterScope, markdownAnchor(domain.ClusterScope)))
+}
+
+func (r *MarkdownReporter) writeSinceLastScan(sb *strings.Builder, comparison *domain.ScanComparison) {
+	if comparison == nil {
+		return
+	}
+
+	sb.WriteString("## 🔄 지난 스캔 이후 변화\n\n")
+	sb.WriteString(fmt.Sprintf("- **이전 스캔**: %s (%s)\n", comparison.PreviousStartTime.Format("2006-01-02 15:04:05"), comparison.PreviousReport))
+	sb.WriteString(fmt.Sprintf("- **수동 리소스**: %d개 → %d개 (%s)\n", comparison.PreviousManual, comparison.CurrentManual, formatChange(comparison.Change())))
+	if len(comparison.MissingNamespaces) > 0 {
+		sb.WriteString(fmt.Sprintf("- **이번에 검사하지 않은 네임스페이스**: %s\n", strings.Join(scopeLabels(comparison.MissingNamespaces), ", ")))
+	}
+	sb.WriteString("\n")
+
+	if len(comparison.Deltas) == 0 {
+		sb.WriteString("변화 없음\n\n")
+		return
+	}
+
+	sb.WriteString("| 네임스페이스 | 이전 | 현재 | 변화 | 신규 수동 리소스 | 관리 도구 편입 | 삭제/제외 |\n")
+	sb.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, delta := range comparison.Deltas {
+		namespace := domain.ScopeLabel(delta.Namespace)
+		if delta.NewNamespace {
+			namespace += " (신규)"
+		}
+		if delta.Incomplete {
+			namespace += " (불완전)"
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d | %d | %s | %s | %s | %s |\n",
+			namespace,
+			delta.PreviousManual,
+			delta.CurrentManual,
+			formatChange(delta.Change()),
+			formatIdentifiers(delta.Appeared),
+			formatIdentifiers(delta.Adopted),
+			formatIdentifiers(delta.Resolved),
+		))
+	}
+	sb.WriteString("\n")
+}
+
+func (r *MarkdownReporter) writeIncompleteScans(sb *strings.Builder, allResults map[string]domain.AnalysisResult, incompleteNamespaces []string) {
+	if len(incompleteNamespaces) == 0 {
+		return
+	}
+
+	sb.WriteString("## ⚠️ 불완전한 스캔\n\n")
+	sb.WriteString(fmt.Sprintf("%d개 네임스페이스에서 일부 리소스 타입을 조회하지 못했습니다. 아래 리소스 타입은 검사 결과에 포함되지 않았습니다.\n\n", len(incompleteNamespaces)))
+	sb.WriteString("| 네임스페이스 | 리소스 타입 | 원인 | 메시지 |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+	for _, ns := range incompleteNamespaces {
+		for _, failure := range allResults[ns].FailedResourceTypes {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+				ns,
+				failure.ResourceType,
+				failure.Reason,
+				strings.ReplaceAll(failure.Message, "|", "\\|"),
+			))
+		}
+	}
+	sb.WriteString("\n")
+}
+
+func (r *MarkdownReporter) writeOrphanedGitOps(sb *strings.Builder, allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
+	if countOrphanedGitOps(allResults) == 0 {
+		return
+	}
+
+	sb.WriteString("## 👻 고아 GitOps 리소스\n\n")
+	sb.WriteString("ArgoCD 추적 정보가 있지만 가리키는 Application이 존재하지 않는 리소스입니다. Application이 삭제되었거나 이름이 바뀌었을 수 있습니다.\n\n")
+	sb.WriteString("| 네임스페이스 | Kind | Name | 참조 Application |" + r.ruleHeader() + "\n")
+	sb.WriteString("| --- | --- | --- | --- |" + r.ruleSeparator() + "\n")
+	for _, ns := range sortedNamespaces {
+		for _, resource := range allResults[ns].OrphanedResourceList {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |%s\n",
+				ns,
+				resource.Identifier.Kind,
+				resource.Identifier.Name,
+				resource.ArgoCDApplication(),
+				r.ruleCell(resource),
+			))
+		}
+	}
+	sb.WriteString("\n")
+}
+
+func (r *MarkdownReporter) writeApplicationBreakdown(sb *strings.Builder, allResults map[string]domain.AnalysisResult) {
+	summaries := summarizeApplications(allResults)
+	if len(summaries) == 0 {
+		return
+	}
+
+	sb.WriteString("## 📦 ArgoCD Application별 현황\n\n")
+	sb.WriteString("\"수동 리소스가 있는 네임스페이스\"는 해당 Application이 배포하는 네임스페이스 중 수동 리소스도 함께 있는 곳입니다.\n\n")
+	sb.WriteString("| Application | 리소스 수 | 네임스페이스 | 수동 리소스가 있는 네임스페이스 |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+	for _, summary := range summaries {
+		manualNamespaces := "-"
+		if len(summary.ManualNamespaces) > 0 {
+			manualNamespaces = "⚠️ " + strings.Join(summary.ManualNamespaces, ", ")
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d | %s | %s |\n",
+			summary.Name,
+			summary.Resources,
+			strings.Join(summary.Namespaces, ", "),
+			manualNamespaces,
+		))
+	}
+	sb.WriteString("\n")
+}
+
+func (r *MarkdownReporter) writeBaselineReview(sb *strings.Builder, allResults map[string]domain.AnalysisResult) {
+	reviewNamespaces := collectBaselineReviewNamespaces(allResults)
+	if len(reviewNamespaces) == 0 {
+		return
+	}
+
+	sb.WriteString("## 📌 재검토가 필요한 베이스라인 항목\n\n")
+	sb.WriteString("만료되었거나 더 이상 수동 리소스로 발견되지 않는 항목입니다. 베이스라인 파일을 갱신하세요.\n\n")
+	sb.WriteString("| 네임스페이스 | Kind | Name | 상태 | 사유 | 담당자 | 만료일 |\n")
+	sb.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, ns := range reviewNamespaces {
+		for _, finding := range allResults[ns].BaselineFindings {
+			expires := finding.Expires
+			if expires == "" {
+				expires = "-"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s | %s |\n",
+				ns,
+				finding.Identifier.Kind,
+				finding.Identifier.Name,
+				baselineStatusLabel(finding.Status),
+				strings.ReplaceAll(finding.Reason, "|", "\\|"),
+				finding.Owner,
+				expires,
+			))
+		}
+	}
+	sb.WriteString("\n")
+}
+
 func (r *MarkdownReporter) writeOverallStatistics(sb *strings.Builder, allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
 	totalNamespaces := len(sortedNamespaces)
 	totalManual := 0
@@ -189,6 +430,8 @@ func (r *MarkdownReporter) writeOverallStatistics(sb *strings.Builder, allResult
 	sb.WriteString(fmt.Sprintf("- **전체 리소스**: %d개\n", totalResources))
 	sb.WriteString(fmt.Sprintf("- **최상위 리소스**: %d개\n", totalRootResources))
 	sb.WriteString(fmt.Sprintf("- **ArgoCD 관리 리소스**: %d개\n", totalArgoCD))
+	sb.WriteString(fmt.Sprintf("- **기타 도구 관리 리소스**: %d개\n", countOtherManaged(allResults)))
+	sb.WriteString(fmt.Sprintf("- **고아 GitOps 리소스**: %d개\n", countOrphanedGitOps(allResults)))
 	sb.WriteString(fmt.Sprintf("- **수동 생성 리소스**: %d개\n", totalManual))
 	sb.WriteString(fmt.Sprintf("- **제외된 기본 리소스**: %d개\n\n", totalExcluded))
 
@@ -207,8 +450,8 @@ func (r *MarkdownReporter) writeManualResourceDetails(sb *strings.Builder, allRe
 		if len(result.ManualResourceList) > 0 {
 			hasManualResources = true
 			sb.WriteString(fmt.Sprintf("### %s\n\n", namespace))
-			sb.WriteString("| API Version | Kind | Name | Created |\n")
-			sb.WriteString("| --- | --- | --- | --- |\n")
+			sb.WriteString("| API Version | Kind | Name | Created | 생성 주체 | 최종 수정 |" + r.ruleHeader() + "\n")
+			sb.WriteString("| --- | --- | --- | --- | --- | --- |" + r.ruleSeparator() + "\n")
 
 			resources := result.ManualResourceList
 			sort.Slice(resources, func(i, j int) bool {
@@ -226,11 +469,14 @@ func (r *MarkdownReporter) writeManualResourceDetails(sb *strings.Builder, allRe
 				if len(created) > 19 {
 					created = created[:19]
 				}
-				sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+				sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |%s\n",
 					resource.Identifier.APIVersion,
 					resource.Identifier.Kind,
 					resource.Identifier.Name,
 					created,
+					fieldManagerOrDash(resource.CreatedBy()),
+					fieldManagerOrDash(resource.LastModifiedBy()),
+					r.ruleCell(resource),
 				))
 			}
 			sb.WriteString("\n")
@@ -241,3 +487,79 @@ func (r *MarkdownReporter) writeManualResourceDetails(sb *strings.Builder, allRe
 		sb.WriteString("✅ 모든 리소스가 ArgoCD로 관리되고 있습니다!\n\n")
 	}
 }
+
+func (r *MarkdownReporter) writeExcludedResources(sb *strings.Builder, allResults map[string]domain.AnalysisResult, sortedNamespaces []string) {
+	sb.WriteString(fmt.Sprintf("## 🔎 제외된 리소스 (%d개)\n\n", countExcludedResources(allResults)))
+	sb.WriteString("기본 리소스로 제외되어 수동 리소스 집계에서 빠진 리소스입니다. 제외 수가 많은 규칙이 실제 수동 리소스를 숨기고 있지 않은지 검토하세요.\n\n")
+
+	summaries := summarizeExclusions(allResults)
+	if len(summaries) == 0 {
+		sb.WriteString("제외된 리소스가 없습니다.\n\n")
+		return
+	}
+
+	sb.WriteString("| 규칙 카테고리 | 패턴 | 제외 수 | 네임스페이스 수 |\n")
+	sb.WriteString("| --- | --- | --- | --- |\n")
+	for _, summary := range summaries {
+		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %d |\n",
+			summary.Rule.Section,
+			escapeTableCell(summary.Rule.Pattern),
+			summary.Resources,
+			len(summary.Namespaces),
+		))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("| 네임스페이스 | Kind | Name | 규칙 카테고리 | 패턴 |\n")
+	sb.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, ns := range sortedNamespaces {
+		for _, resource := range allResults[ns].ExcludedResourceList {
+			rule := domain.DecisionRule{Section: "-"}
+			if resource.DecidedBy != nil {
+				rule = *resource.DecidedBy
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n",
+				ns,
+				resource.Identifier.Kind,
+				resource.Identifier.Name,
+				rule.Section,
+				escapeTableCell(rule.Pattern),
+			))
+		}
+	}
+	sb.WriteString("\n")
+}
+
+// 정규식 패턴의 '|'가 표 구분자로 해석되지 않도록 이스케이프한다
+func escapeTableCell(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// managedFields가 없는 리소스(오래된 클러스터나 managedFields를 제거한 덤프)는 주체를 알 수 없다
+func fieldManagerOrDash(manager string) string {
+	if manager == "" {
+		return "-"
+	}
+	return manager
+}
+
+func (r *MarkdownReporter) ruleHeader() string {
+	if !r.showRules {
+		return ""
+	}
+	return " 결정 규칙 |"
+}
+
+func (r *MarkdownReporter) ruleSeparator() string {
+	if !r.showRules {
+		return ""
+	}
+	return " --- |"
+}
+
+func (r *MarkdownReporter) ruleCell(resource domain.KubernetesResource) string {
+	if !r.showRules {
+		return ""
+	}
+	return " " + escapeTableCell(decisionRule(resource)) + " |"
+}
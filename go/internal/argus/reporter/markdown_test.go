@@ -1,6 +1,7 @@
 package reporter
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,7 +13,7 @@ import (
 
 func TestNewMarkdownReporter(t *testing.T) {
 	reportDir := "/tmp/test"
-	reporter := NewMarkdownReporter(reportDir)
+	reporter := NewMarkdownReporter(reportDir, "")
 
 	if reporter == nil {
 		t.Fatal("NewMarkdownReporter()가 nil을 반환했습니다")
@@ -72,10 +73,10 @@ func TestMarkdownReporter_Generate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tmpDir := t.TempDir()
-			reporter := NewMarkdownReporter(tmpDir)
+			reporter := NewMarkdownReporter(tmpDir, "")
 
 			startTime := time.Now()
-			err := reporter.Generate(tt.results, tt.context, tt.cluster, startTime)
+			err := reporter.Generate(context.Background(), tt.results, domain.RunInfo{Context: tt.context, Cluster: tt.cluster, StartTime: startTime})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Generate() error = %v, wantErr %v", err, tt.wantErr)
@@ -165,6 +166,113 @@ func TestGenerateMarkdownContent(t *testing.T) {
 				"2개 수동 리소스",
 			},
 		},
+		{
+			name: "클러스터 범위 리소스",
+			results: map[string]domain.AnalysisResult{
+				domain.ClusterScope: {
+					TotalResources:  3,
+					ArgoCDManaged:   2,
+					ManualResources: 1,
+					ManualResourceList: []domain.KubernetesResource{
+						{Identifier: domain.ResourceIdentifier{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass", Name: "fast", Namespace: domain.ClusterScope}},
+					},
+				},
+			},
+			contains: []string{
+				"## 🌐 클러스터 범위 리소스",
+				"- **수동 생성**: 1개",
+				"| StorageClass | 1 |",
+				"- [(cluster)](#cluster) - 1개 수동 리소스",
+				"### (cluster)",
+			},
+		},
+		{
+			name: "수동 리소스의 생성/수정 주체",
+			results: map[string]domain.AnalysisResult{
+				"app": {
+					ManualResources: 2,
+					ManualResourceList: []domain.KubernetesResource{
+						{
+							Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: "ConfigMap", Name: "edited"},
+							ManagedFields: []domain.ManagedFieldEntry{
+								{Manager: "kubectl-create", Operation: "Update", Time: "2024-01-01T00:00:00Z"},
+								{Manager: "kubectl-edit", Operation: "Update", Time: "2024-02-01T00:00:00Z"},
+							},
+						},
+						{Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: "Secret", Name: "legacy"}},
+					},
+				},
+			},
+			contains: []string{
+				"| API Version | Kind | Name | Created | 생성 주체 | 최종 수정 |",
+				"| v1 | ConfigMap | edited |  | kubectl-create | kubectl-edit |",
+				"| v1 | Secret | legacy |  | - | - |",
+			},
+		},
+		{
+			name: "일부 리소스 타입 조회 실패",
+			results: map[string]domain.AnalysisResult{
+				"restricted": {
+					TotalResources: 3,
+					ArgoCDManaged:  3,
+					FailedResourceTypes: []domain.ResourceTypeFailure{
+						{ResourceType: "secrets", Reason: "forbidden", Message: "secrets is forbidden"},
+					},
+				},
+			},
+			context: "test-context",
+			cluster: "test-cluster",
+			contains: []string{
+				"## ⚠️ 불완전한 스캔",
+				"| restricted | secrets | forbidden | secrets is forbidden |",
+				"## ⚠️ 수동 리소스 없음 (불완전한 스캔)",
+			},
+		},
+		{
+			name: "Application별 현황",
+			results: map[string]domain.AnalysisResult{
+				"shop": {
+					TotalResources:     2,
+					RootResources:      2,
+					ArgoCDManaged:      1,
+					ManualResources:    1,
+					ArgoCDResourceList: []domain.KubernetesResource{argoCDResource("Deployment", "web", "shop-app")},
+					ManualResourceList: []domain.KubernetesResource{{Identifier: domain.ResourceIdentifier{Kind: "Secret", Name: "manual"}}},
+				},
+			},
+			context: "test-context",
+			cluster: "test-cluster",
+			contains: []string{
+				"## 📦 ArgoCD Application별 현황",
+				"| shop-app | 1 | shop | ⚠️ shop |",
+			},
+		},
+		{
+			name: "재검토가 필요한 베이스라인 항목",
+			results: map[string]domain.AnalysisResult{
+				"app": {
+					TotalResources:     2,
+					RootResources:      2,
+					ArgoCDManaged:      1,
+					BaselineSuppressed: 1,
+					BaselineFindings: []domain.BaselineFinding{
+						{
+							Identifier: domain.ResourceIdentifier{Kind: "RoleBinding", Name: "break-glass"},
+							Status:     domain.BaselineExpired,
+							Reason:     "비상 접근",
+							Owner:      "sre",
+							Expires:    "2024-05-31",
+						},
+					},
+				},
+			},
+			context: "test-context",
+			cluster: "test-cluster",
+			contains: []string{
+				"## 📌 재검토가 필요한 베이스라인 항목",
+				"| app | RoleBinding | break-glass | 만료됨 | 비상 접근 | sre | 2024-05-31 |",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,7 +280,7 @@ func TestGenerateMarkdownContent(t *testing.T) {
 			reporter := &MarkdownReporter{}
 			startTime := time.Now()
 
-			content := reporter.generateMarkdownContent(tt.results, tt.context, tt.cluster, startTime)
+			content := reporter.generateMarkdownContent(tt.results, domain.RunInfo{Context: tt.context, Cluster: tt.cluster, StartTime: startTime})
 
 			for _, expected := range tt.contains {
 				if !strings.Contains(content, expected) {
@@ -186,7 +294,7 @@ func TestGenerateMarkdownContent(t *testing.T) {
 func TestMarkdownReporter_DirectoryCreation(t *testing.T) {
 	// 존재하지 않는 깊은 경로 테스트
 	deepPath := filepath.Join(t.TempDir(), "deep", "nested", "path")
-	reporter := NewMarkdownReporter(deepPath)
+	reporter := NewMarkdownReporter(deepPath, "")
 
 	results := map[string]domain.AnalysisResult{
 		"test": {
@@ -194,7 +302,7 @@ func TestMarkdownReporter_DirectoryCreation(t *testing.T) {
 		},
 	}
 
-	err := reporter.Generate(results, "test", "test", time.Now())
+	err := reporter.Generate(context.Background(), results, domain.RunInfo{Context: "test", Cluster: "test", StartTime: time.Now()})
 	if err != nil {
 		t.Errorf("디렉토리 생성이 실패했습니다: %v", err)
 	}
@@ -217,3 +325,117 @@ func TestMarkdownReporter_FileNaming(t *testing.T) {
 		t.Errorf("파일명 형식이 잘못되었습니다. got = %v, want %v", actualFileName, expectedFileName)
 	}
 }
+
+func TestGenerateMarkdownContent_SinceLastScan(t *testing.T) {
+	reporter := &MarkdownReporter{}
+	run := domain.RunInfo{
+		Context:   "test-context",
+		StartTime: time.Now(),
+		Comparison: &domain.ScanComparison{
+			PreviousReport:    "reports/20240101_000000.json",
+			PreviousStartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			PreviousManual:    3,
+			CurrentManual:     2,
+			Deltas: []domain.NamespaceDelta{
+				{
+					Namespace:      "app",
+					PreviousManual: 3,
+					CurrentManual:  2,
+					Appeared:       []domain.ResourceIdentifier{{Kind: "Deployment", Name: "hotfix"}},
+					Adopted:        []domain.ResourceIdentifier{{Kind: "ConfigMap", Name: "settings"}},
+					Resolved:       []domain.ResourceIdentifier{{Kind: "Secret", Name: "old"}},
+				},
+				{
+					Namespace:     domain.ClusterScope,
+					CurrentManual: 1,
+					NewNamespace:  true,
+					Appeared:      []domain.ResourceIdentifier{{Kind: "ClusterRole", Name: "debug"}},
+				},
+			},
+			MissingNamespaces: []string{"legacy"},
+		},
+	}
+
+	content := reporter.generateMarkdownContent(map[string]domain.AnalysisResult{"app": {ManualResources: 2}}, run)
+
+	for _, expected := range []string{
+		"## 🔄 지난 스캔 이후 변화",
+		"- **이전 스캔**: 2024-01-01 00:00:00 (reports/20240101_000000.json)",
+		"- **수동 리소스**: 3개 → 2개 (-1)",
+		"| app | 3 | 2 | -1 | Deployment/hotfix | ConfigMap/settings | Secret/old |",
+		"| 클러스터 범위 (신규) | 0 | 1 | +1 | ClusterRole/debug | - | - |",
+		"- **이번에 검사하지 않은 네임스페이스**: legacy",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("컨텐츠에 '%s'가 포함되어야 합니다", expected)
+		}
+	}
+}
+
+func TestGenerateMarkdownContent_DecisionRules(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app": {
+			ManualResources: 1,
+			ManualResourceList: []domain.KubernetesResource{
+				{Identifier: domain.ResourceIdentifier{APIVersion: "v1", Kind: "ConfigMap", Name: "hand-made"}},
+			},
+			OrphanedGitOps: 1,
+			OrphanedResourceList: []domain.KubernetesResource{
+				{
+					Identifier: domain.ResourceIdentifier{Kind: "Secret", Name: "stale"},
+					Labels:     map[string]string{domain.ArgoCDInstanceLabel: "deleted"},
+					DecidedBy:  &domain.DecisionRule{Section: "argocd.verify_applications", Pattern: "Application deleted 없음"},
+				},
+			},
+		},
+	}
+	run := domain.RunInfo{StartTime: time.Now()}
+
+	hidden := (&MarkdownReporter{}).generateMarkdownContent(results, run)
+	if strings.Contains(hidden, "결정 규칙") {
+		t.Error("기본 설정에서는 결정 규칙 열이 없어야 합니다")
+	}
+
+	reporter := &MarkdownReporter{}
+	reporter.SetShowDecisionRules(true)
+	content := reporter.generateMarkdownContent(results, run)
+	for _, expected := range []string{
+		"| API Version | Kind | Name | Created | 생성 주체 | 최종 수정 | 결정 규칙 |",
+		"| v1 | ConfigMap | hand-made |  | - | - | 일치하는 규칙 없음 |",
+		"| app | Secret | stale | deleted | argocd.verify_applications: Application deleted 없음 |",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("컨텐츠에 '%s'가 포함되어야 합니다", expected)
+		}
+	}
+}
+
+func TestGenerateMarkdownContent_ExcludedResources(t *testing.T) {
+	results := map[string]domain.AnalysisResult{
+		"app": {
+			ExcludedDefaults: 2,
+			ExcludedResourceList: []domain.KubernetesResource{
+				excludedBy("Job", "migrate", "exclusions.others", "*/Job/*"),
+				excludedBy("Secret", "default-token-abcde", "patterns.secret_patterns", `^.*-token-[a-z0-9]+$|^sa-`),
+			},
+		},
+	}
+	run := domain.RunInfo{StartTime: time.Now()}
+
+	if content := (&MarkdownReporter{}).generateMarkdownContent(results, run); strings.Contains(content, "제외된 리소스") {
+		t.Error("기본 설정에서는 제외된 리소스 섹션이 없어야 합니다")
+	}
+
+	reporter := &MarkdownReporter{}
+	reporter.SetShowExcluded(true)
+	content := reporter.generateMarkdownContent(results, run)
+	for _, expected := range []string{
+		"## 🔎 제외된 리소스 (2개)",
+		"| exclusions.others | */Job/* | 1 | 1 |",
+		"| app | Secret | default-token-abcde | patterns.secret_patterns | ^.*-token-[a-z0-9]+$\\|^sa- |",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("컨텐츠에 '%s'가 포함되어야 합니다", expected)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package reporter
+
+import (
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+func countOrphanedGitOps(results map[string]domain.AnalysisResult) int {
+	total := 0
+	for _, result := range results {
+		total += result.OrphanedGitOps
+	}
+	return total
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
+)
+
+// Explanation은 리소스 하나의 분류 결과이며, 분류를 결정한 규칙은 Resource.DecidedBy에 있다
+type Explanation struct {
+	Resource       domain.KubernetesResource
+	Classification domain.Classification
+}
+
+// ExplainResource는 namespace의 리소스를 조회해 kind/name이 일치하는 리소스가 어떻게 분류되는지 반환한다.
+// Kind는 대소문자를 구분하지 않으며, 같은 Kind가 여러 API 그룹에 있으면 일치하는 리소스를 모두 반환한다.
+func (s *ScannerService) ExplainResource(ctx context.Context, namespace, kind, name string) ([]Explanation, error) {
+	var resourceTypes []string
+	var err error
+	if namespace == domain.ClusterScope {
+		resourceTypes, err = s.k8sClient.GetClusterResourceTypes(ctx)
+	} else {
+		resourceTypes, err = s.k8sClient.GetResourceTypes(ctx, true)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("리소스 타입 조회 실패: %w", err)
+	}
+
+	if s.config.ArgoCD.VerifyApplications {
+		s.loadArgoCDApplications(ctx)
+	}
+
+	scan := &namespaceScan{}
+	s.processBatchesInParallel(ctx, s.createResourceTypeBatches(resourceTypes), namespace, scan)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.namespaceMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	namespaceRule := s.namespaceExclusionRule(namespace, metadata)
+
+	var explanations []Explanation
+	for _, resource := range scan.resources {
+		if !strings.EqualFold(resource.Identifier.Kind, kind) || resource.Identifier.Name != name {
+			continue
+		}
+
+		classification := s.analyzer.Classify(&resource)
+		// 네임스페이스 전체가 제외되면 리소스 규칙과 관계없이 스캔 대상이 아니다
+		if namespaceRule != nil && classification != domain.ClassificationChild {
+			classification = domain.ClassificationExcluded
+			resource.Manager = ""
+			resource.DecidedBy = namespaceRule
+		}
+		explanations = append(explanations, Explanation{Resource: resource, Classification: classification})
+	}
+
+	if len(explanations) == 0 {
+		if failures := scan.sortedFailures(); len(failures) > 0 {
+			return nil, fmt.Errorf("%s/%s/%s 리소스를 찾을 수 없습니다 (조회 실패한 리소스 타입 %d개)", namespace, kind, name, len(failures))
+		}
+		return nil, fmt.Errorf("%s/%s/%s 리소스를 찾을 수 없습니다", namespace, kind, name)
+	}
+	return explanations, nil
+}
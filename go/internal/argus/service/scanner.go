@@ -1,11 +1,13 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
-	"time"
 
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/analyzer"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
@@ -39,19 +41,43 @@ func (s *ScannerService) GetCurrentContext() (string, string) {
 	return s.k8sClient.GetCurrentContext()
 }
 
-func (s *ScannerService) GetAllNamespaces() ([]string, error) {
-	allNamespaces, err := s.k8sClient.GetAllNamespaces()
+func (s *ScannerService) GetAllNamespaces(ctx context.Context) ([]string, error) {
+	allNamespaces, err := s.k8sClient.GetAllNamespaces(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.filterExcludedNamespaces(allNamespaces), nil
+	metadata, err := s.namespaceMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.filterExcludedNamespaces(allNamespaces, metadata), nil
+}
+
+// namespaceMetadata는 네임스페이스 선택자가 있을 때만 Namespace 객체를 조회해 이름별로 반환하며, 선택자가 없으면 nil이다
+func (s *ScannerService) namespaceMetadata(ctx context.Context) (map[string]*domain.KubernetesResource, error) {
+	if s.config.NamespaceSelectors.IsEmpty() {
+		return nil, nil
+	}
+
+	objects, err := s.k8sClient.GetResources(ctx, "namespaces", "")
+	if err != nil {
+		return nil, fmt.Errorf("네임스페이스 라벨 조회 실패: %w", err)
+	}
+
+	metadata := make(map[string]*domain.KubernetesResource, len(objects))
+	for _, obj := range objects {
+		if ns := analyzer.MapToResource(obj, "", s.config); ns != nil {
+			metadata[ns.Identifier.Name] = ns
+		}
+	}
+	return metadata, nil
 }
 
-func (s *ScannerService) filterExcludedNamespaces(namespaces []string) []string {
+func (s *ScannerService) filterExcludedNamespaces(namespaces []string, metadata map[string]*domain.KubernetesResource) []string {
 	var filtered []string
 	for _, ns := range namespaces {
-		if !s.isNamespaceExcluded(ns) {
+		if s.namespaceExclusionRule(ns, metadata) == nil {
 			filtered = append(filtered, ns)
 		}
 	}
@@ -59,22 +85,39 @@ func (s *ScannerService) filterExcludedNamespaces(namespaces []string) []string
 }
 
 func (s *ScannerService) isNamespaceExcluded(namespace string) bool {
+	return s.namespaceExclusionRule(namespace, nil) != nil
+}
+
+// namespaceExclusionRule은 네임스페이스 전체를 제외하는 규칙을 반환하며, 없으면 nil이다.
+// 네임스페이스 선택자는 metadata가 있을 때만 적용하며, 클러스터 범위에는 적용하지 않는다.
+func (s *ScannerService) namespaceExclusionRule(namespace string, metadata map[string]*domain.KubernetesResource) *domain.DecisionRule {
 	for _, rule := range s.config.ExclusionRules {
 		if s.isWholeNamespaceRule(rule) && rule.Match(namespace, "*", "*") {
-			return true
+			return &domain.DecisionRule{Section: "exclusions." + rule.Category, Pattern: rule.Pattern}
 		}
 	}
-	return false
+
+	if metadata == nil || namespace == domain.ClusterScope {
+		return nil
+	}
+	var labels, annotations map[string]string
+	if ns := metadata[namespace]; ns != nil {
+		labels, annotations = ns.Labels, ns.Annotations
+	}
+	if section, expression := s.config.NamespaceSelectors.Excluded(labels, annotations); section != "" {
+		return &domain.DecisionRule{Section: section, Pattern: expression}
+	}
+	return nil
 }
 
 func (s *ScannerService) isWholeNamespaceRule(rule config.ExclusionRule) bool {
 	return rule.Kind == "*" && rule.Name == "*"
 }
 
-func (s *ScannerService) ValidateNamespaces(namespaces []string) ([]string, error) {
+func (s *ScannerService) ValidateNamespaces(ctx context.Context, namespaces []string) ([]string, error) {
 	s.printValidationStart()
 
-	validationResults, err := s.k8sClient.ValidateNamespacesBatch(namespaces)
+	validationResults, err := s.k8sClient.ValidateNamespacesBatch(ctx, namespaces)
 	if err != nil {
 		return nil, fmt.Errorf("네임스페이스 검증 실패: %w", err)
 	}
@@ -112,13 +155,20 @@ func (s *ScannerService) printInvalidNamespace(namespace string) {
 	fmt.Printf("%s❌ 네임스페이스 '%s'가 존재하지 않습니다%s\n", color.Red, namespace, color.NC)
 }
 
-func (s *ScannerService) AnalyzeNamespaces(namespaces []string, maxConcurrent int) (map[string]domain.AnalysisResult, error) {
+// AnalyzeNamespaces는 ctx가 취소되면 진행 중인 요청을 중단하고
+// 분석이 끝난 네임스페이스의 결과와 함께 ctx.Err()를 반환한다
+func (s *ScannerService) AnalyzeNamespaces(ctx context.Context, namespaces []string, maxConcurrent int) (map[string]domain.AnalysisResult, error) {
 	s.printResourceTypeQueryStart()
-	resourceTypes, err := s.k8sClient.GetResourceTypes(true)
+	resourceTypes, err := s.k8sClient.GetResourceTypes(ctx, true)
 	if err != nil {
 		return nil, fmt.Errorf("리소스 타입 조회 실패: %w", err)
 	}
 	s.printResourceTypeCount(len(resourceTypes))
+
+	if s.config.ArgoCD.VerifyApplications {
+		s.loadArgoCDApplications(ctx)
+	}
+
 	maxConcurrent = s.optimizeConcurrency(namespaces, maxConcurrent)
 	s.printParallelProcessingStart(len(namespaces), maxConcurrent)
 
@@ -126,12 +176,153 @@ func (s *ScannerService) AnalyzeNamespaces(namespaces []string, maxConcurrent in
 	resultsChan := make(chan domain.NamespaceAnalysis, len(namespaces))
 
 	var progress int32
-	allResults := s.processNamespacesInParallel(workChan, resultsChan, resourceTypes, maxConcurrent, &progress, len(namespaces))
+	allResults := s.processNamespacesInParallel(ctx, workChan, resultsChan, resourceTypes, maxConcurrent, &progress, len(namespaces))
+
+	if err := ctx.Err(); err != nil {
+		fmt.Printf("\n\n%s⚠️ 분석 중단됨: %d/%d 네임스페이스 완료%s\n", color.Yellow, len(allResults), len(namespaces), color.NC)
+		s.addDiscoveryFailures(allResults)
+		return allResults, err
+	}
 
 	fmt.Printf("\n\n%s✓%s 모든 네임스페이스 분석 완료\n", color.Green, color.NC)
+
+	if s.config.ScanClusterScope && !s.isNamespaceExcluded(domain.ClusterScope) {
+		result, err := s.analyzeClusterScope(ctx)
+		if ctx.Err() != nil {
+			fmt.Printf("\n%s⚠️ 클러스터 범위 분석 중단됨%s\n", color.Yellow, color.NC)
+			s.addDiscoveryFailures(allResults)
+			return allResults, ctx.Err()
+		}
+		if err != nil {
+			// 결과를 빼면 이전 클러스터 범위 항목이 모두 해소된 것처럼 보이므로 불완전한 결과로 기록한다
+			fmt.Printf("%s⚠️ 클러스터 범위 리소스를 분석하지 못했습니다 (불완전한 스캔으로 기록): %v%s\n", color.Yellow, err, color.NC)
+			result = domain.AnalysisResult{FailedResourceTypes: clusterScopeFailure(err)}
+		}
+		allResults[domain.ClusterScope] = result
+	}
+
+	s.addDiscoveryFailures(allResults)
 	return allResults, nil
 }
 
+// DiscoveryFailures는 discovery에 실패해 스캔 대상에서 빠진 API 그룹을 반환한다.
+// 클라이언트가 DiscoveryFailureReporter를 구현하지 않으면 nil이다.
+func (s *ScannerService) DiscoveryFailures() []domain.ResourceTypeFailure {
+	reporter, ok := s.k8sClient.(k8sinterface.DiscoveryFailureReporter)
+	if !ok {
+		return nil
+	}
+
+	var failures []domain.ResourceTypeFailure
+	for _, f := range reporter.DiscoveryFailures() {
+		failures = append(failures, domain.ResourceTypeFailure{
+			ResourceType: f.ResourceType,
+			Reason:       f.Reason,
+			Message:      f.Err.Error(),
+		})
+	}
+	return failures
+}
+
+// addDiscoveryFailures는 discovery에 실패한 API 그룹의 리소스가 어느 범위에든 있을 수 있으므로
+// 모든 결과를 조회 실패가 있는 불완전한 스캔으로 기록한다
+func (s *ScannerService) addDiscoveryFailures(results map[string]domain.AnalysisResult) {
+	failures := s.DiscoveryFailures()
+	if len(failures) == 0 {
+		return
+	}
+	for ns, result := range results {
+		result.FailedResourceTypes = append(append([]domain.ResourceTypeFailure(nil), failures...), result.FailedResourceTypes...)
+		results[ns] = result
+	}
+}
+
+// analyzeClusterScope는 클러스터 범위 리소스를 네임스페이스와 같은 분류/제외 규칙으로 분석한다
+func (s *ScannerService) analyzeClusterScope(ctx context.Context) (domain.AnalysisResult, error) {
+	fmt.Printf("\n%s⏳ 클러스터 범위 리소스 분석 중...%s\n", color.Cyan, color.NC)
+
+	resourceTypes, err := s.k8sClient.GetClusterResourceTypes(ctx)
+	if err != nil {
+		return domain.AnalysisResult{}, fmt.Errorf("클러스터 범위 리소스 타입 조회 실패: %w", err)
+	}
+
+	scan := &namespaceScan{}
+	s.processBatchesInParallel(ctx, s.createResourceTypeBatches(resourceTypes), domain.ClusterScope, scan)
+	if err := ctx.Err(); err != nil {
+		return domain.AnalysisResult{}, err
+	}
+
+	result := s.analyzer.AnalyzeResources(scan.resources)
+	result.FailedResourceTypes = scan.sortedFailures()
+
+	fmt.Printf("%s✓%s 클러스터 범위 리소스 타입 %d개 분석 완료 (수동 리소스 %d개)\n", color.Green, color.NC, len(resourceTypes), result.ManualResources)
+	return result, nil
+}
+
+// clusterScopeFailureResourceType은 클러스터 범위 리소스 타입 목록 자체를 조회하지 못했을 때 실패 항목에 쓰는 이름이다
+const clusterScopeFailureResourceType = "*"
+
+func clusterScopeFailure(err error) []domain.ResourceTypeFailure {
+	reason := k8sinterface.FailureError
+	var rtErr *k8sinterface.ResourceTypeError
+	if errors.As(err, &rtErr) {
+		reason = rtErr.Reason
+	}
+	return []domain.ResourceTypeFailure{{ResourceType: clusterScopeFailureResourceType, Reason: reason, Message: err.Error()}}
+}
+
+const argoCDApplicationResource = "applications.argoproj.io"
+
+// loadArgoCDApplications는 모든 네임스페이스의 Application 목록을 분석기에 등록한다 (apps-in-any-namespace 구성 포함).
+// 클러스터 전체 조회 권한이 없으면 ArgoCD 네임스페이스만 조회하고, 다른 네임스페이스의 Application을 가리키는 리소스는 검사하지 않는다.
+// 조회에 실패하거나 Application이 하나도 없으면 고아 리소스 검사 없이 기존 방식으로 분석한다.
+func (s *ScannerService) loadArgoCDApplications(ctx context.Context) {
+	argoCDNamespace := s.config.GetArgoCDNamespace()
+
+	scope := "전체 네임스페이스"
+	items, err := s.k8sClient.GetResources(ctx, argoCDApplicationResource, "")
+	if err != nil {
+		clusterErr := err
+		items, err = s.k8sClient.GetResources(ctx, argoCDApplicationResource, argoCDNamespace)
+		if err != nil {
+			fmt.Printf("%s⚠️ ArgoCD Application 조회 실패, 고아 GitOps 리소스 검사를 건너뜁니다: %v%s\n", color.Yellow, err, color.NC)
+			return
+		}
+		fmt.Printf("%s⚠️ 전체 네임스페이스의 ArgoCD Application을 조회하지 못해 %s 네임스페이스의 Application만 확인합니다: %v%s\n", color.Yellow, argoCDNamespace, clusterErr, color.NC)
+		scope = argoCDNamespace
+		s.analyzer.SkipQualifiedApplications()
+	}
+
+	// argocd.namespace가 잘못되었으면 목록이 비어 있다.
+	// 이때 검사하면 추적 정보가 있는 모든 리소스가 고아로 분류되므로 검사를 건너뛴다.
+	if len(items) == 0 {
+		fmt.Printf("%s⚠️ ArgoCD Application이 없어 고아 GitOps 리소스 검사를 건너뜁니다 (%s, argocd.namespace 설정 확인)%s\n", color.Yellow, scope, color.NC)
+		return
+	}
+
+	applications := make(map[string]bool)
+	for _, item := range items {
+		metadata, ok := item["metadata"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := metadata["name"].(string)
+		namespace, _ := metadata["namespace"].(string)
+		if name == "" {
+			continue
+		}
+
+		// ArgoCD 네임스페이스 밖의 Application은 "<네임스페이스>_<이름>"으로 추적된다
+		applications[namespace+"_"+name] = true
+		if namespace == "" || namespace == argoCDNamespace {
+			applications[name] = true
+		}
+	}
+
+	s.analyzer.SetArgoCDApplications(applications)
+	fmt.Printf("%s✓%s ArgoCD Application %d개 확인 (%s)\n", color.Green, color.NC, len(items), scope)
+}
+
 func (s *ScannerService) printResourceTypeQueryStart() {
 	fmt.Printf("\n%s⏳ 리소스 타입 조회 중...%s\n", color.Cyan, color.NC)
 }
@@ -177,6 +368,7 @@ func (s *ScannerService) createWorkChannel(namespaces []string) chan string {
 }
 
 func (s *ScannerService) processNamespacesInParallel(
+	ctx context.Context,
 	workChan chan string,
 	resultsChan chan domain.NamespaceAnalysis,
 	resourceTypes []string,
@@ -191,7 +383,10 @@ func (s *ScannerService) processNamespacesInParallel(
 		go func() {
 			defer wg.Done()
 			for namespace := range workChan {
-				s.processNamespace(resourceTypes, namespace, resultsChan, progress, totalNamespaces)
+				if ctx.Err() != nil {
+					return
+				}
+				s.processNamespace(ctx, resourceTypes, namespace, resultsChan, progress, totalNamespaces)
 			}
 		}()
 	}
@@ -216,19 +411,26 @@ func (s *ScannerService) processNamespacesInParallel(
 }
 
 func (s *ScannerService) processNamespace(
+	ctx context.Context,
 	resourceTypes []string,
 	namespace string,
 	results chan<- domain.NamespaceAnalysis,
 	progress *int32,
 	totalNamespaces int,
 ) {
-	var allResources []domain.KubernetesResource
-	var resourceMutex sync.Mutex
+	scan := &namespaceScan{}
 
 	batches := s.createResourceTypeBatches(resourceTypes)
-	s.processBatchesInParallel(batches, namespace, &allResources, &resourceMutex)
+	s.processBatchesInParallel(ctx, batches, namespace, scan)
 
-	result := s.analyzer.AnalyzeResources(allResources)
+	// 중단된 네임스페이스는 결과가 불완전하므로 리포트에서 제외한다
+	if err := ctx.Err(); err != nil {
+		results <- domain.NamespaceAnalysis{Namespace: namespace, Error: err}
+		return
+	}
+
+	result := s.analyzer.AnalyzeResources(scan.resources)
+	result.FailedResourceTypes = scan.sortedFailures()
 	s.updateProgress(progress, totalNamespaces)
 
 	results <- domain.NamespaceAnalysis{
@@ -272,10 +474,10 @@ func (s *ScannerService) calculateBatchSize(resourceTypeCount int) int {
 }
 
 func (s *ScannerService) processBatchesInParallel(
+	ctx context.Context,
 	batches [][]string,
 	namespace string,
-	allResources *[]domain.KubernetesResource,
-	resourceMutex *sync.Mutex,
+	scan *namespaceScan,
 ) {
 	batchChan := make(chan []string, len(batches))
 	for _, batch := range batches {
@@ -292,7 +494,7 @@ func (s *ScannerService) processBatchesInParallel(
 	for i := 0; i < concurrency; i++ {
 		go func() {
 			defer wg.Done()
-			s.processBatchWorker(batchChan, namespace, allResources, resourceMutex)
+			s.processBatchWorker(ctx, batchChan, namespace, scan)
 		}()
 	}
 
@@ -300,24 +502,58 @@ func (s *ScannerService) processBatchesInParallel(
 }
 
 func (s *ScannerService) processBatchWorker(
+	ctx context.Context,
 	batchChan chan []string,
 	namespace string,
-	allResources *[]domain.KubernetesResource,
-	resourceMutex *sync.Mutex,
+	scan *namespaceScan,
 ) {
 	for batch := range batchChan {
-		resources, err := s.k8sClient.GetResourcesBatch(batch, namespace)
+		if ctx.Err() != nil {
+			return
+		}
+
+		resources, err := s.k8sClient.GetResourcesBatch(ctx, batch, apiNamespace(namespace))
 		if err != nil {
-			continue
+			scan.addFailures(toResourceTypeFailures(batch, err))
 		}
 
 		localResources := s.convertToKubernetesResources(resources, namespace)
-		if len(localResources) > 0 {
-			resourceMutex.Lock()
-			*allResources = append(*allResources, localResources...)
-			resourceMutex.Unlock()
+		scan.addResources(localResources)
+	}
+}
+
+// 클러스터 범위 리소스는 네임스페이스 없이 조회한다
+func apiNamespace(namespace string) string {
+	if namespace == domain.ClusterScope {
+		return ""
+	}
+	return namespace
+}
+
+func toResourceTypeFailures(batch []string, err error) []domain.ResourceTypeFailure {
+	var batchErr *k8sinterface.BatchError
+	if errors.As(err, &batchErr) {
+		failures := make([]domain.ResourceTypeFailure, 0, len(batchErr.Failures))
+		for _, f := range batchErr.Failures {
+			failures = append(failures, domain.ResourceTypeFailure{
+				ResourceType: f.ResourceType,
+				Reason:       f.Reason,
+				Message:      f.Err.Error(),
+			})
 		}
+		return failures
+	}
+
+	// 배치 전체가 실패한 경우 배치의 모든 리소스 타입을 실패로 기록
+	failures := make([]domain.ResourceTypeFailure, 0, len(batch))
+	for _, resourceType := range batch {
+		failures = append(failures, domain.ResourceTypeFailure{
+			ResourceType: resourceType,
+			Reason:       k8sinterface.FailureError,
+			Message:      err.Error(),
+		})
 	}
+	return failures
 }
 
 func (s *ScannerService) convertToKubernetesResources(resources []map[string]interface{}, namespace string) []domain.KubernetesResource {
@@ -330,16 +566,50 @@ func (s *ScannerService) convertToKubernetesResources(resources []map[string]int
 	return kubeResources
 }
 
+type namespaceScan struct {
+	mu        sync.Mutex
+	resources []domain.KubernetesResource
+	failures  []domain.ResourceTypeFailure
+}
+
+func (n *namespaceScan) addResources(resources []domain.KubernetesResource) {
+	if len(resources) == 0 {
+		return
+	}
+	n.mu.Lock()
+	n.resources = append(n.resources, resources...)
+	n.mu.Unlock()
+}
+
+func (n *namespaceScan) addFailures(failures []domain.ResourceTypeFailure) {
+	if len(failures) == 0 {
+		return
+	}
+	n.mu.Lock()
+	n.failures = append(n.failures, failures...)
+	n.mu.Unlock()
+}
+
+func (n *namespaceScan) sortedFailures() []domain.ResourceTypeFailure {
+	sort.Slice(n.failures, func(i, j int) bool {
+		return n.failures[i].ResourceType < n.failures[j].ResourceType
+	})
+	return n.failures
+}
+
 func (s *ScannerService) updateProgress(progress *int32, total int) {
 	current := atomic.AddInt32(progress, 1)
 	fmt.Printf("\r%s진행중: %d/%d 완료%s", color.Cyan, current, total, color.NC)
 }
 
-func (s *ScannerService) GenerateReports(allResults map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+func (s *ScannerService) GenerateReports(ctx context.Context, allResults map[string]domain.AnalysisResult, run domain.RunInfo) error {
 	s.printReportHeader()
 
 	for _, reporter := range s.reporters {
-		if err := reporter.Generate(allResults, context, cluster, startTime); err != nil {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("리포트 생성 중단됨: %w", err)
+		}
+		if err := reporter.Generate(ctx, allResults, run); err != nil {
 			s.printReportGenerationWarning(err)
 		}
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/config"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/domain"
 	"gitlab.bellsoft.net/devops/sre-workbench/go/internal/argus/reporter"
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
 )
 
 // Mock K8sClient
@@ -16,26 +18,35 @@ type mockK8sClient struct {
 	currentCluster    string
 	namespaces        []string
 	resourceTypes     []string
+	clusterTypes      []string
 	resources         []map[string]interface{}
+	clusterResources  []map[string]interface{}
 	validationResults map[string]bool
 	returnError       bool
 	resourceTypeError bool
+	clusterTypeError  bool
 	validationError   bool
 	getBatchError     bool
+	batchError        error
+	onGetBatch        func()
+	applicationError  bool
+	noApplications    bool
+	// applicationNamespaceOnly이면 Application을 네임스페이스를 지정해서만 조회할 수 있다
+	applicationNamespaceOnly bool
 }
 
 func (m *mockK8sClient) GetCurrentContext() (string, string) {
 	return m.currentContext, m.currentCluster
 }
 
-func (m *mockK8sClient) GetAllNamespaces() ([]string, error) {
+func (m *mockK8sClient) GetAllNamespaces(ctx context.Context) ([]string, error) {
 	if m.returnError {
 		return nil, errors.New("mock error")
 	}
 	return m.namespaces, nil
 }
 
-func (m *mockK8sClient) ValidateNamespacesBatch(namespaces []string) (map[string]bool, error) {
+func (m *mockK8sClient) ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error) {
 	if m.validationError {
 		return nil, errors.New("validation error")
 	}
@@ -49,34 +60,72 @@ func (m *mockK8sClient) ValidateNamespacesBatch(namespaces []string) (map[string
 	return m.validationResults, nil
 }
 
-func (m *mockK8sClient) GetResourceTypes(includeNamespaced bool) ([]string, error) {
+func (m *mockK8sClient) GetResourceTypes(ctx context.Context, includeNamespaced bool) ([]string, error) {
 	if m.resourceTypeError {
 		return nil, errors.New("resource type error")
 	}
 	return m.resourceTypes, nil
 }
 
-func (m *mockK8sClient) GetResourcesBatch(resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+func (m *mockK8sClient) GetClusterResourceTypes(ctx context.Context) ([]string, error) {
+	if m.resourceTypeError || m.clusterTypeError {
+		return nil, errors.New("resource type error")
+	}
+	return m.clusterTypes, nil
+}
+
+func (m *mockK8sClient) GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+	if m.onGetBatch != nil {
+		m.onGetBatch()
+	}
+	if namespace == "" {
+		return m.clusterResources, nil
+	}
 	if m.getBatchError {
 		return nil, errors.New("get batch error")
 	}
+	if m.batchError != nil {
+		return m.resources, m.batchError
+	}
 	return m.resources, nil
 }
 
-func (m *mockK8sClient) GetResources(resourceType, namespace string) ([]map[string]interface{}, error) {
-	if m.returnError {
+func (m *mockK8sClient) GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error) {
+	if m.returnError || m.applicationError {
 		return nil, errors.New("mock error")
 	}
+	if resourceType == argoCDApplicationResource {
+		if m.noApplications {
+			return nil, nil
+		}
+		if m.applicationNamespaceOnly && namespace == "" {
+			return nil, errors.New("forbidden")
+		}
+		return filterNamespace(m.resources, namespace), nil
+	}
 	return m.resources, nil
 }
 
+func filterNamespace(objects []map[string]interface{}, namespace string) []map[string]interface{} {
+	if namespace == "" {
+		return objects
+	}
+	var filtered []map[string]interface{}
+	for _, obj := range objects {
+		if metadata, _ := obj["metadata"].(map[string]interface{}); metadata["namespace"] == namespace {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
 // Mock Reporter
 type mockReporter struct {
 	generateCalled bool
 	returnError    bool
 }
 
-func (m *mockReporter) Generate(results map[string]domain.AnalysisResult, context, cluster string, startTime time.Time) error {
+func (m *mockReporter) Generate(ctx context.Context, results map[string]domain.AnalysisResult, run domain.RunInfo) error {
 	m.generateCalled = true
 	if m.returnError {
 		return errors.New("reporter error")
@@ -125,10 +174,10 @@ func TestGetCurrentContext(t *testing.T) {
 	}
 	scanner := &ScannerService{k8sClient: mockClient}
 
-	context, cluster := scanner.GetCurrentContext()
+	kubeContext, cluster := scanner.GetCurrentContext()
 
-	if context != "test-context" {
-		t.Errorf("context = %v, want test-context", context)
+	if kubeContext != "test-context" {
+		t.Errorf("context = %v, want test-context", kubeContext)
 	}
 	if cluster != "test-cluster" {
 		t.Errorf("cluster = %v, want test-cluster", cluster)
@@ -140,6 +189,8 @@ func TestGetAllNamespaces(t *testing.T) {
 		name           string
 		namespaces     []string
 		exclusionRules []config.ExclusionRule
+		selectors      config.SelectorRules
+		namespaceObjs  []map[string]interface{}
 		returnError    bool
 		want           []string
 		wantErr        bool
@@ -157,6 +208,27 @@ func TestGetAllNamespaces(t *testing.T) {
 			},
 			want: []string{"default", "test"},
 		},
+		{
+			name:       "네임스페이스 include 선택자",
+			namespaces: []string{"billing", "payments", "search"},
+			selectors:  config.SelectorRules{IncludeLabels: []string{"team=payments"}},
+			namespaceObjs: []map[string]interface{}{
+				namespaceObject("billing", map[string]interface{}{"team": "payments"}),
+				namespaceObject("payments", map[string]interface{}{"team": "payments"}),
+				namespaceObject("search", map[string]interface{}{"team": "search"}),
+			},
+			want: []string{"billing", "payments"},
+		},
+		{
+			name:       "네임스페이스 제외 선택자",
+			namespaces: []string{"default", "sandbox"},
+			selectors:  config.SelectorRules{ExcludeLabels: []string{"env=sandbox"}},
+			namespaceObjs: []map[string]interface{}{
+				namespaceObject("default", nil),
+				namespaceObject("sandbox", map[string]interface{}{"env": "sandbox"}),
+			},
+			want: []string{"default"},
+		},
 		{
 			name:        "에러 반환",
 			returnError: true,
@@ -168,14 +240,19 @@ func TestGetAllNamespaces(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := &mockK8sClient{
 				namespaces:  tt.namespaces,
+				resources:   tt.namespaceObjs,
 				returnError: tt.returnError,
 			}
+			namespaceSelectors, err := config.NewSelectorSet("namespaces", tt.selectors)
+			if err != nil {
+				t.Fatal(err)
+			}
 			scanner := &ScannerService{
 				k8sClient: mockClient,
-				config:    &config.Config{ExclusionRules: tt.exclusionRules},
+				config:    &config.Config{ExclusionRules: tt.exclusionRules, NamespaceSelectors: namespaceSelectors},
 			}
 
-			got, err := scanner.GetAllNamespaces()
+			got, err := scanner.GetAllNamespaces(context.Background())
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetAllNamespaces() error = %v, wantErr %v", err, tt.wantErr)
@@ -189,6 +266,14 @@ func TestGetAllNamespaces(t *testing.T) {
 	}
 }
 
+func namespaceObject(name string, labels map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{"name": name}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+	return map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": metadata}
+}
+
 func TestValidateNamespaces(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -241,7 +326,7 @@ func TestValidateNamespaces(t *testing.T) {
 				config:    &config.Config{},
 			}
 
-			got, err := scanner.ValidateNamespaces(tt.namespaces)
+			got, err := scanner.ValidateNamespaces(context.Background(), tt.namespaces)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ValidateNamespaces() error = %v, wantErr %v", err, tt.wantErr)
@@ -345,7 +430,7 @@ func TestAnalyzeNamespaces(t *testing.T) {
 			cfg := &config.Config{BatchSize: 5}
 			scanner := NewScannerService(cfg, mockClient)
 
-			results, err := scanner.AnalyzeNamespaces(tt.namespaces, 5)
+			results, err := scanner.AnalyzeNamespaces(context.Background(), tt.namespaces, 5)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AnalyzeNamespaces() error = %v, wantErr %v", err, tt.wantErr)
@@ -359,6 +444,300 @@ func TestAnalyzeNamespaces(t *testing.T) {
 	}
 }
 
+func TestAnalyzeNamespaces_RecordsFailedResourceTypes(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceTypes []string
+		getBatchError bool
+		batchError    error
+		discovery     []*k8sinterface.ResourceTypeError
+		wantFailures  []domain.ResourceTypeFailure
+	}{
+		{
+			name:          "일부 리소스 타입 실패",
+			resourceTypes: []string{"secrets", "configmaps"},
+			batchError: &k8sinterface.BatchError{
+				Failures: []*k8sinterface.ResourceTypeError{
+					{ResourceType: "secrets", Reason: k8sinterface.FailureForbidden, Err: errors.New("forbidden")},
+				},
+			},
+			wantFailures: []domain.ResourceTypeFailure{
+				{ResourceType: "secrets", Reason: k8sinterface.FailureForbidden, Message: "forbidden"},
+			},
+		},
+		{
+			name:          "배치 전체 실패",
+			resourceTypes: []string{"services", "configmaps"},
+			getBatchError: true,
+			wantFailures: []domain.ResourceTypeFailure{
+				{ResourceType: "configmaps", Reason: k8sinterface.FailureError, Message: "get batch error"},
+				{ResourceType: "services", Reason: k8sinterface.FailureError, Message: "get batch error"},
+			},
+		},
+		{
+			name:          "discovery 실패는 모든 네임스페이스에 기록",
+			resourceTypes: []string{"configmaps"},
+			discovery: []*k8sinterface.ResourceTypeError{
+				{ResourceType: "metrics.k8s.io/v1beta1", Reason: k8sinterface.FailureDiscovery, Err: errors.New("service unavailable")},
+			},
+			wantFailures: []domain.ResourceTypeFailure{
+				{ResourceType: "metrics.k8s.io/v1beta1", Reason: k8sinterface.FailureDiscovery, Message: "service unavailable"},
+			},
+		},
+		{
+			name:          "실패 없음",
+			resourceTypes: []string{"services"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &mockK8sClient{
+				resourceTypes: tt.resourceTypes,
+				resources: []map[string]interface{}{
+					{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "cm", "namespace": "default"},
+					},
+				},
+				getBatchError: tt.getBatchError,
+				batchError:    tt.batchError,
+			}
+			var client k8sinterface.K8sClient = mockClient
+			if tt.discovery != nil {
+				client = &discoveryFailureClient{mockK8sClient: mockClient, failures: tt.discovery}
+			}
+			scanner := NewScannerService(&config.Config{BatchSize: 5}, client)
+
+			results, err := scanner.AnalyzeNamespaces(context.Background(), []string{"default"}, 1)
+			if err != nil {
+				t.Fatalf("AnalyzeNamespaces() error = %v", err)
+			}
+
+			result := results["default"]
+			if len(result.FailedResourceTypes) != len(tt.wantFailures) {
+				t.Fatalf("FailedResourceTypes = %v, want %v", result.FailedResourceTypes, tt.wantFailures)
+			}
+			for i, want := range tt.wantFailures {
+				if result.FailedResourceTypes[i] != want {
+					t.Errorf("FailedResourceTypes[%d] = %v, want %v", i, result.FailedResourceTypes[i], want)
+				}
+			}
+			if result.IsIncomplete() != (len(tt.wantFailures) > 0) {
+				t.Errorf("IsIncomplete() = %v", result.IsIncomplete())
+			}
+			if tt.batchError != nil && result.TotalResources != 1 {
+				t.Errorf("부분 실패 시 성공한 리소스는 유지되어야 합니다: TotalResources = %v", result.TotalResources)
+			}
+		})
+	}
+}
+
+// discoveryFailureClient는 일부 API 그룹의 discovery에 실패한 클라이언트를 흉내 낸다
+type discoveryFailureClient struct {
+	*mockK8sClient
+	failures []*k8sinterface.ResourceTypeError
+}
+
+func (c *discoveryFailureClient) DiscoveryFailures() []*k8sinterface.ResourceTypeError {
+	return c.failures
+}
+
+func TestAnalyzeNamespaces_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient := &mockK8sClient{
+		resourceTypes: []string{"configmaps"},
+		onGetBatch:    cancel,
+	}
+	scanner := NewScannerService(&config.Config{BatchSize: 5}, mockClient)
+
+	namespaces := []string{"ns-a", "ns-b", "ns-c"}
+	results, err := scanner.AnalyzeNamespaces(ctx, namespaces, 1)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("AnalyzeNamespaces() error = %v, want context.Canceled", err)
+	}
+	if results == nil {
+		t.Fatal("중단 시에도 완료된 결과 맵을 반환해야 합니다")
+	}
+	if len(results) >= len(namespaces) {
+		t.Errorf("중단 후 결과 수 = %v, want < %v", len(results), len(namespaces))
+	}
+}
+
+func TestGenerateReports_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockReporter := &mockReporter{}
+	scanner := &ScannerService{reporters: []reporter.Reporter{mockReporter}}
+
+	err := scanner.GenerateReports(ctx, map[string]domain.AnalysisResult{}, domain.RunInfo{})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("GenerateReports() error = %v, want context.Canceled", err)
+	}
+	if mockReporter.generateCalled {
+		t.Error("중단된 컨텍스트에서 리포터가 호출되었습니다")
+	}
+}
+
+func TestAnalyzeNamespaces_OrphanedGitOps(t *testing.T) {
+	tests := []struct {
+		name                     string
+		returnError              bool
+		noApplications           bool
+		applicationNamespaceOnly bool
+		wantOrphaned             int
+	}{
+		{
+			name:         "존재하지 않는 Application 참조",
+			wantOrphaned: 1,
+		},
+		{
+			name:                     "ArgoCD 네임스페이스만 조회 가능하면 다른 네임스페이스의 Application 참조는 검사 생략",
+			applicationNamespaceOnly: true,
+			wantOrphaned:             1,
+		},
+		{
+			name:         "Application 조회 실패 시 검사 생략",
+			returnError:  true,
+			wantOrphaned: 0,
+		},
+		{
+			name:           "Application이 하나도 없으면 검사 생략",
+			noApplications: true,
+			wantOrphaned:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &mockK8sClient{
+				resourceTypes: []string{"configmaps"},
+				resources: []map[string]interface{}{
+					{
+						"apiVersion": "argoproj.io/v1alpha1",
+						"kind":       "Application",
+						"metadata": map[string]interface{}{
+							"name":      "live-app",
+							"namespace": "argocd",
+							"labels":    map[string]interface{}{"argocd.argoproj.io/instance": "deleted-app"},
+						},
+					},
+					{
+						// apps-in-any-namespace: team-a 네임스페이스의 Application은 "team-a_web"으로 추적된다
+						"apiVersion": "argoproj.io/v1alpha1",
+						"kind":       "Application",
+						"metadata": map[string]interface{}{
+							"name":        "web",
+							"namespace":   "team-a",
+							"annotations": map[string]interface{}{"argocd.argoproj.io/tracking-id": "team-a_web:argoproj.io/Application:team-a/web"},
+						},
+					},
+				},
+				applicationError:         tt.returnError,
+				noApplications:           tt.noApplications,
+				applicationNamespaceOnly: tt.applicationNamespaceOnly,
+			}
+			cfg := &config.Config{BatchSize: 5, ArgoCD: config.ArgoCDConfig{VerifyApplications: true}}
+			scanner := NewScannerService(cfg, mockClient)
+
+			results, err := scanner.AnalyzeNamespaces(context.Background(), []string{"default"}, 1)
+			if err != nil {
+				t.Fatalf("AnalyzeNamespaces() error = %v", err)
+			}
+
+			if got := results["default"].OrphanedGitOps; got != tt.wantOrphaned {
+				t.Errorf("OrphanedGitOps = %v, want %v", got, tt.wantOrphaned)
+			}
+		})
+	}
+}
+
+func TestAnalyzeNamespaces_ClusterScope(t *testing.T) {
+	clusterRole := func(name string, labels map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata":   map[string]interface{}{"name": name, "labels": labels},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		clusterTypeErr bool
+		exclusions     []config.ExclusionRule
+		wantResult     bool
+		wantIncomplete bool
+	}{
+		{name: "비활성화", enabled: false},
+		{
+			name:           "클러스터 범위 조회 실패는 불완전한 결과로 기록",
+			enabled:        true,
+			clusterTypeErr: true,
+			wantResult:     true,
+			wantIncomplete: true,
+		},
+		{
+			name:       "활성화",
+			enabled:    true,
+			exclusions: []config.ExclusionRule{{Namespace: domain.ClusterScope, Kind: "ClusterRole", Name: "system:*"}},
+			wantResult: true,
+		},
+		{
+			name:       "클러스터 범위 전체 제외",
+			enabled:    true,
+			exclusions: []config.ExclusionRule{{Namespace: domain.ClusterScope, Kind: "*", Name: "*"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &mockK8sClient{
+				resourceTypes: []string{"configmaps"},
+				clusterTypes:  []string{"clusterroles.rbac.authorization.k8s.io"},
+				clusterResources: []map[string]interface{}{
+					clusterRole("system:controller", nil),
+					clusterRole("argocd-role", map[string]interface{}{"argocd.argoproj.io/instance": "rbac"}),
+					clusterRole("hand-made", nil),
+				},
+				clusterTypeError: tt.clusterTypeErr,
+			}
+			cfg := &config.Config{BatchSize: 5, ScanClusterScope: tt.enabled, ExclusionRules: tt.exclusions}
+
+			results, err := NewScannerService(cfg, mockClient).AnalyzeNamespaces(context.Background(), []string{"default"}, 1)
+			if err != nil {
+				t.Fatalf("AnalyzeNamespaces() error = %v", err)
+			}
+
+			result, ok := results[domain.ClusterScope]
+			if ok != tt.wantResult {
+				t.Fatalf("클러스터 범위 결과 존재 = %v, want %v", ok, tt.wantResult)
+			}
+			if !tt.wantResult {
+				return
+			}
+			if result.IsIncomplete() != tt.wantIncomplete {
+				t.Fatalf("IsIncomplete() = %v, want %v (%v)", result.IsIncomplete(), tt.wantIncomplete, result.FailedResourceTypes)
+			}
+			if tt.wantIncomplete {
+				return
+			}
+			if result.ArgoCDManaged != 1 || result.ManualResources != 1 || result.ExcludedDefaults != 1 {
+				t.Errorf("ArgoCD/수동/제외 = %v/%v/%v, want 1/1/1", result.ArgoCDManaged, result.ManualResources, result.ExcludedDefaults)
+			}
+			if id := result.ManualResourceList[0].Identifier; id.Name != "hand-made" || id.Namespace != domain.ClusterScope {
+				t.Errorf("ManualResourceList[0] = %+v", id)
+			}
+		})
+	}
+}
+
 func TestCalculateBatchSize(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -435,7 +814,7 @@ func TestGenerateReports(t *testing.T) {
 				"default": {},
 			}
 
-			err := scanner.GenerateReports(results, "test-context", "test-cluster", time.Now())
+			err := scanner.GenerateReports(context.Background(), results, domain.RunInfo{Context: "test-context", Cluster: "test-cluster", StartTime: time.Now()})
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GenerateReports() error = %v, wantErr %v", err, tt.wantErr)
@@ -502,3 +881,95 @@ func equalSlices(a, b []string) bool {
 	}
 	return true
 }
+
+func TestExplainResource(t *testing.T) {
+	configMap := func(name string, annotations map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "app", "annotations": annotations},
+		}
+	}
+	mockClient := &mockK8sClient{
+		resourceTypes: []string{"configmaps"},
+		resources: []map[string]interface{}{
+			configMap("kube-root-ca.crt", nil),
+			configMap("synced", map[string]interface{}{"argocd.argoproj.io/tracking-id": "web:/ConfigMap:app/synced"}),
+			configMap("hand-made", nil),
+		},
+	}
+
+	tests := []struct {
+		name               string
+		exclusions         []config.ExclusionRule
+		kind               string
+		resourceName       string
+		wantClassification domain.Classification
+		wantRule           string
+		wantErr            bool
+	}{
+		{
+			name:               "제외 규칙",
+			exclusions:         []config.ExclusionRule{{Namespace: "*", Kind: "ConfigMap", Name: "kube-root-ca.crt", Pattern: "*/ConfigMap/kube-root-ca.crt", Category: "defaults"}},
+			kind:               "configmap",
+			resourceName:       "kube-root-ca.crt",
+			wantClassification: domain.ClassificationExcluded,
+			wantRule:           "exclusions.defaults: */ConfigMap/kube-root-ca.crt",
+		},
+		{
+			name:               "ArgoCD 추적 어노테이션",
+			kind:               "ConfigMap",
+			resourceName:       "synced",
+			wantClassification: domain.ClassificationArgoCD,
+			wantRule:           "argocd (기본 어노테이션): argocd.argoproj.io/tracking-id",
+		},
+		{
+			name:               "일치하는 규칙 없음",
+			kind:               "ConfigMap",
+			resourceName:       "hand-made",
+			wantClassification: domain.ClassificationManual,
+		},
+		{
+			name:               "네임스페이스 전체 제외",
+			exclusions:         []config.ExclusionRule{{Namespace: "app", Kind: "*", Name: "*", Pattern: "app/*/*", Category: "others"}},
+			kind:               "ConfigMap",
+			resourceName:       "hand-made",
+			wantClassification: domain.ClassificationExcluded,
+			wantRule:           "exclusions.others: app/*/*",
+		},
+		{
+			name:         "없는 리소스",
+			kind:         "Secret",
+			resourceName: "hand-made",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{BatchSize: 5, ExclusionRules: tt.exclusions}
+			explanations, err := NewScannerService(cfg, mockClient).ExplainResource(context.Background(), "app", tt.kind, tt.resourceName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExplainResource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(explanations) != 1 {
+				t.Fatalf("explanations = %d개, want 1", len(explanations))
+			}
+
+			explanation := explanations[0]
+			if explanation.Classification != tt.wantClassification {
+				t.Errorf("Classification = %v, want %v", explanation.Classification, tt.wantClassification)
+			}
+			var rule string
+			if explanation.Resource.DecidedBy != nil {
+				rule = explanation.Resource.DecidedBy.String()
+			}
+			if rule != tt.wantRule {
+				t.Errorf("DecidedBy = %q, want %q", rule, tt.wantRule)
+			}
+		})
+	}
+}
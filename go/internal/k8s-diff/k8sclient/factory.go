@@ -189,7 +189,7 @@ func (c *K8sClientWrapper) GetAllResourceTypes() ([]string, error) {
 }
 
 // GetResourcesInNamespace 네임스페이스의 특정 리소스 타입 가져오기
-func (c *K8sClientWrapper) GetResourcesInNamespace(resourceType, namespace string) ([]unstructured.Unstructured, error) {
+func (c *K8sClientWrapper) GetResourcesInNamespace(ctx context.Context, resourceType, namespace string) ([]unstructured.Unstructured, error) {
 	// 리소스 타입 파싱
 	parts := strings.SplitN(resourceType, ".", 2)
 	var gvr schema.GroupVersionResource
@@ -212,7 +212,7 @@ func (c *K8sClientWrapper) GetResourcesInNamespace(resourceType, namespace strin
 	}
 
 	// 리소스 조회
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	list, err := c.dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
@@ -273,14 +273,14 @@ func (c *K8sClientWrapper) GetCurrentContext() (string, string) {
 }
 
 // GetAllNamespaces 모든 네임스페이스 가져오기 (인터페이스 구현)
-func (c *K8sClientWrapper) GetAllNamespaces() ([]string, error) {
+func (c *K8sClientWrapper) GetAllNamespaces(ctx context.Context) ([]string, error) {
 	// 기존 Client의 메서드 사용
 	if c.Client != nil {
-		return c.Client.GetAllNamespaces()
+		return c.Client.GetAllNamespaces(ctx)
 	}
 
 	// 또는 직접 구현
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	nsList, err := c.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
@@ -296,13 +296,37 @@ func (c *K8sClientWrapper) GetAllNamespaces() ([]string, error) {
 }
 
 // GetResourceTypes 리소스 타입 가져오기 (인터페이스 구현)
-func (c *K8sClientWrapper) GetResourceTypes(namespaced bool) ([]string, error) {
+func (c *K8sClientWrapper) GetResourceTypes(ctx context.Context, namespaced bool) ([]string, error) {
 	// 모든 리소스 타입 가져오기 (CRD 포함)
 	return c.GetAllResourceTypes()
 }
 
+// GetClusterResourceTypes 클러스터 범위 리소스 타입 가져오기 (인터페이스 구현)
+func (c *K8sClientWrapper) GetClusterResourceTypes(ctx context.Context) ([]string, error) {
+	apiResourceLists, err := c.clientset.Discovery().ServerPreferredResources()
+	if err != nil && len(apiResourceLists) == 0 {
+		return nil, err
+	}
+
+	var resourceTypes []string
+	for _, apiResourceList := range apiResourceLists {
+		gv, _ := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		for _, apiResource := range apiResourceList.APIResources {
+			if apiResource.Namespaced || !contains(apiResource.Verbs, "list") || strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			if gv.Group == "" {
+				resourceTypes = append(resourceTypes, apiResource.Name)
+			} else {
+				resourceTypes = append(resourceTypes, apiResource.Name+"."+gv.Group)
+			}
+		}
+	}
+	return resourceTypes, nil
+}
+
 // GetResourcesBatch 배치로 리소스 가져오기 (인터페이스 구현)
-func (c *K8sClientWrapper) GetResourcesBatch(resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+func (c *K8sClientWrapper) GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
 	var allResources []map[string]interface{}
 	var mu sync.Mutex
 	var wg sync.WaitGroup
@@ -312,7 +336,7 @@ func (c *K8sClientWrapper) GetResourcesBatch(resourceTypes []string, namespace s
 		go func(rt string) {
 			defer wg.Done()
 
-			resources, err := c.GetResources(rt, namespace)
+			resources, err := c.GetResources(ctx, rt, namespace)
 			if err != nil {
 				// 에러 무시 (권한 없는 리소스 등)
 				return
@@ -329,8 +353,8 @@ func (c *K8sClientWrapper) GetResourcesBatch(resourceTypes []string, namespace s
 }
 
 // GetResources 특정 리소스 타입의 리소스 가져오기 (인터페이스 구현)
-func (c *K8sClientWrapper) GetResources(resourceType, namespace string) ([]map[string]interface{}, error) {
-	resources, err := c.GetResourcesInNamespace(resourceType, namespace)
+func (c *K8sClientWrapper) GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error) {
+	resources, err := c.GetResourcesInNamespace(ctx, resourceType, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -343,11 +367,11 @@ func (c *K8sClientWrapper) GetResources(resourceType, namespace string) ([]map[s
 }
 
 // ValidateNamespacesBatch 네임스페이스 유효성 검증 (인터페이스 구현)
-func (c *K8sClientWrapper) ValidateNamespacesBatch(namespaces []string) (map[string]bool, error) {
+func (c *K8sClientWrapper) ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error) {
 	result := make(map[string]bool)
 
 	// 현재 존재하는 모든 네임스페이스 가져오기
-	existingNamespaces, err := c.GetAllNamespaces()
+	existingNamespaces, err := c.GetAllNamespaces(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -51,12 +52,12 @@ func (s *ScannerService) GetClusterInfo() (source, target domain.ClusterInfo) {
 
 // GetAllNamespaces 모든 네임스페이스 가져오기
 func (s *ScannerService) GetAllNamespaces() ([]string, error) {
-	sourceNamespaces, err := s.sourceClient.GetAllNamespaces()
+	sourceNamespaces, err := s.sourceClient.GetAllNamespaces(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("소스 클러스터 네임스페이스 조회 실패: %w", err)
 	}
 
-	targetNamespaces, err := s.targetClient.GetAllNamespaces()
+	targetNamespaces, err := s.targetClient.GetAllNamespaces(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("타겟 클러스터 네임스페이스 조회 실패: %w", err)
 	}
@@ -97,12 +98,12 @@ func (s *ScannerService) isNamespaceExcluded(namespace string) bool {
 func (s *ScannerService) ValidateNamespaces(namespaces []string) ([]string, error) {
 	fmt.Printf("\n%s⏳ 네임스페이스 검증 중...%s\n", color.Cyan, color.NC)
 
-	sourceValidation, err := s.sourceClient.ValidateNamespacesBatch(namespaces)
+	sourceValidation, err := s.sourceClient.ValidateNamespacesBatch(context.Background(), namespaces)
 	if err != nil {
 		return nil, fmt.Errorf("소스 클러스터 네임스페이스 검증 실패: %w", err)
 	}
 
-	targetValidation, err := s.targetClient.ValidateNamespacesBatch(namespaces)
+	targetValidation, err := s.targetClient.ValidateNamespacesBatch(context.Background(), namespaces)
 	if err != nil {
 		return nil, fmt.Errorf("타겟 클러스터 네임스페이스 검증 실패: %w", err)
 	}
@@ -194,7 +195,7 @@ func (s *ScannerService) getResourceTypes() ([]string, error) {
 	}
 
 	// 소스 클러스터에서 리소스 타입 조회
-	resourceTypes, err := s.sourceClient.GetResourceTypes(true)
+	resourceTypes, err := s.sourceClient.GetResourceTypes(context.Background(), true)
 	if err != nil {
 		return nil, fmt.Errorf("리소스 타입 조회 실패: %w", err)
 	}
@@ -266,7 +267,7 @@ func (s *ScannerService) getNamespaceResources(client k8sinterface.K8sClient, na
 		go func(batch []string) {
 			defer wg.Done()
 
-			resources, err := client.GetResourcesBatch(batch, namespace)
+			resources, err := client.GetResourcesBatch(context.Background(), batch, namespace)
 			if err != nil {
 				return
 			}
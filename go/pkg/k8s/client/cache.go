@@ -0,0 +1,104 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultDiscoveryCacheTTL = 6 * time.Hour
+	DefaultNamespaceCacheTTL = 5 * time.Minute
+
+	discoveryCacheFile = "discovery.json"
+	namespaceCacheFile = "namespaces.json"
+)
+
+var unsafeCachePathChars = regexp.MustCompile(`[^a-zA-Z0-9.\-]`)
+
+// diskCache는 discovery 결과와 네임스페이스 목록을 클러스터 서버 URL별 디렉토리에 저장한다.
+// 실행마다 반복되는 조회를 줄이기 위한 것이므로 읽기/쓰기 실패는 캐시가 없는 것으로 처리한다.
+type diskCache struct {
+	dir string
+}
+
+type cacheEntry struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Server  string          `json:"server"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// newDiskCache는 baseDir가 비어 있으면 nil을 반환한다 (nil 캐시는 항상 비어 있다)
+func newDiskCache(baseDir, server string) *diskCache {
+	if baseDir == "" {
+		return nil
+	}
+	return &diskCache{dir: filepath.Join(baseDir, cacheDirName(server))}
+}
+
+// cacheDirName은 https://10.0.0.1:6443 같은 서버 URL을 10.0.0.1_6443처럼 디렉토리 이름으로 쓸 수 있게 바꾼다
+func cacheDirName(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	return unsafeCachePathChars.ReplaceAllString(server, "_")
+}
+
+// load는 ttl 이내에 저장된 항목이 있으면 v에 채우고 저장 시각과 true를 반환한다
+func (c *diskCache) load(name string, ttl time.Duration, v interface{}) (time.Time, bool) {
+	if c == nil || ttl <= 0 {
+		return time.Time{}, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, name))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return time.Time{}, false
+	}
+	if time.Since(entry.SavedAt) > ttl {
+		return time.Time{}, false
+	}
+	if err := json.Unmarshal(entry.Data, v); err != nil {
+		return time.Time{}, false
+	}
+	return entry.SavedAt, true
+}
+
+func (c *diskCache) save(name, server string, v interface{}) error {
+	if c == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	entry, err := json.Marshal(cacheEntry{SavedAt: time.Now(), Server: server, Data: data})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return fmt.Errorf("캐시 디렉토리 생성 실패: %w", err)
+	}
+	// 동시에 실행된 다른 argus가 쓰다 만 파일을 읽지 않도록 임시 파일에 쓴 뒤 이름을 바꾼다
+	tmp, err := os.CreateTemp(c.dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(entry); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(c.dir, name))
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestCacheDirName(t *testing.T) {
+	tests := []struct {
+		server string
+		want   string
+	}{
+		{server: "https://10.0.0.1:6443", want: "10.0.0.1_6443"},
+		{server: "https://api.prod.example.com/k8s/clusters/c-abc", want: "api.prod.example.com_k8s_clusters_c-abc"},
+		{server: "http://localhost:8080", want: "localhost_8080"},
+	}
+
+	for _, tt := range tests {
+		if got := cacheDirName(tt.server); got != tt.want {
+			t.Errorf("cacheDirName(%q) = %q, want %q", tt.server, got, tt.want)
+		}
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	cache := newDiskCache(t.TempDir(), "https://10.0.0.1:6443")
+	if err := cache.save(namespaceCacheFile, "https://10.0.0.1:6443", []string{"default", "app"}); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	var namespaces []string
+	if _, ok := cache.load(namespaceCacheFile, time.Minute, &namespaces); !ok {
+		t.Fatal("load() = false, want true")
+	}
+	if want := []string{"default", "app"}; !reflect.DeepEqual(namespaces, want) {
+		t.Errorf("load() = %v, want %v", namespaces, want)
+	}
+
+	if _, ok := cache.load(discoveryCacheFile, time.Minute, &namespaces); ok {
+		t.Error("저장하지 않은 항목을 읽었습니다")
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if _, ok := cache.load(namespaceCacheFile, time.Millisecond, &namespaces); ok {
+		t.Error("TTL이 지난 항목을 읽었습니다")
+	}
+
+	var disabled *diskCache
+	if err := disabled.save(namespaceCacheFile, "", []string{"default"}); err != nil {
+		t.Errorf("nil 캐시 save() error = %v", err)
+	}
+	if _, ok := disabled.load(namespaceCacheFile, time.Minute, &namespaces); ok {
+		t.Error("nil 캐시 load() = true, want false")
+	}
+}
+
+func TestClient_DiscoveryCache(t *testing.T) {
+	cacheDir := t.TempDir()
+	resources := []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Verbs: metav1.Verbs{"list"}}}},
+	}
+
+	newClient := func(discoveryClient discovery.DiscoveryInterface) *Client {
+		cfg := &ClientConfig{CacheDir: cacheDir}
+		cfg.applyDefaults()
+		return &Client{config: cfg, discoveryClient: discoveryClient, server: "https://10.0.0.1:6443", cache: newDiskCache(cacheDir, "https://10.0.0.1:6443")}
+	}
+
+	first := newClient(&fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: resources}})
+	if _, err := first.getAPIResourceLists(); err != nil {
+		t.Fatalf("getAPIResourceLists() error = %v", err)
+	}
+
+	// 두 번째 실행은 discovery가 실패해도 디스크 캐시를 사용한다
+	failing := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{}}
+	failing.AddReactor("get", "resource", func(kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+	second := newClient(failing)
+	got, err := second.getAPIResourceLists()
+	if err != nil {
+		t.Fatalf("캐시 사용 시 getAPIResourceLists() error = %v", err)
+	}
+	if len(got) != 1 || got[0].GroupVersion != "v1" {
+		t.Errorf("getAPIResourceLists() = %v, want 캐시된 v1 목록", got)
+	}
+
+	// 캐시를 무시하면 discovery 오류가 그대로 반환되고, 다시 호출해도 같은 오류를 반환한다
+	refresh := newClient(failing)
+	refresh.config.RefreshCache = true
+	for i := 0; i < 2; i++ {
+		if _, err := refresh.getAPIResourceLists(); err == nil {
+			t.Errorf("%d번째 getAPIResourceLists() error = nil, want error", i+1)
+		}
+	}
+}
+
+func TestClient_PartialDiscoveryFailure(t *testing.T) {
+	cacheDir := t.TempDir()
+	discoveryClient := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{
+		{GroupVersion: "v1", APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Verbs: metav1.Verbs{"list"}}}},
+	}}}
+	metrics := schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"}
+	discoveryClient.AddReactor("get", "resource", func(kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{metrics: errors.New("service unavailable")}}
+	})
+
+	cfg := &ClientConfig{CacheDir: cacheDir}
+	cfg.applyDefaults()
+	c := &Client{config: cfg, discoveryClient: discoveryClient, cache: newDiskCache(cacheDir, "https://10.0.0.1:6443")}
+
+	types, err := c.GetResourceTypes(context.Background(), true)
+	if err != nil {
+		t.Fatalf("GetResourceTypes() error = %v", err)
+	}
+	if want := []string{"configmaps"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("GetResourceTypes() = %v, want %v", types, want)
+	}
+	failures := c.DiscoveryFailures()
+	if len(failures) != 1 || failures[0].ResourceType != "metrics.k8s.io/v1beta1" || failures[0].Reason != k8sinterface.FailureDiscovery {
+		t.Errorf("DiscoveryFailures() = %v, want metrics.k8s.io/v1beta1 discovery 실패", failures)
+	}
+
+	var cached []*metav1.APIResourceList
+	if _, ok := c.cache.load(discoveryCacheFile, time.Minute, &cached); ok {
+		t.Error("일부 그룹이 실패한 discovery 결과가 캐시에 저장되었습니다")
+	}
+}
+
+func TestClient_ValidateNamespacesRefreshesCache(t *testing.T) {
+	cfg := &ClientConfig{}
+	cfg.applyDefaults()
+	c := &Client{
+		config: cfg,
+		clientset: fake.NewSimpleClientset(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "new-app"}},
+		),
+	}
+	c.storeNamespaces([]string{"default"}, time.Now())
+
+	result, err := c.ValidateNamespacesBatch(context.Background(), []string{"default", "new-app", "missing"})
+	if err != nil {
+		t.Fatalf("ValidateNamespacesBatch() error = %v", err)
+	}
+	want := map[string]bool{"default": true, "new-app": true, "missing": false}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("ValidateNamespacesBatch() = %v, want %v", result, want)
+	}
+}
@@ -2,40 +2,138 @@ package client
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/http"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/homedir"
 	"k8s.io/klog/v2"
 )
 
+const (
+	DefaultTimeout     = 30 * time.Second
+	DefaultMaxAttempts = 3
+	DefaultBackoffBase = time.Second
+	DefaultBackoffMax  = 30 * time.Second
+
+	DefaultQPS         = 20
+	DefaultBurst       = 40
+	DefaultMaxInFlight = 10
+)
+
 type ClientConfig struct {
 	ImportantResourceTypes []string
 	SkipResourceTypes      map[string]bool
+
+	// Timeout은 REST 요청과 리소스 목록 조회 1회 시도에 적용된다
+	Timeout time.Duration
+	// MaxAttempts는 첫 시도를 포함한 리소스 목록 조회 시도 횟수이다 (1이면 재시도하지 않음)
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+
+	// MetadataOnly이면 리소스를 PartialObjectMetadataList로 조회해 메타데이터만 받는다.
+	// 라벨/어노테이션/ownerReferences만 필요한 경우 ConfigMap/Secret 본문 등을 전송하지 않아 대역폭과 메모리를 줄인다.
+	MetadataOnly bool
+
+	// QPS와 Burst는 모든 API 요청이 공유하는 토큰 버킷 설정이다 (QPS가 음수이면 제한하지 않음)
+	QPS   float32
+	Burst int
+	// MaxInFlight는 동시에 진행하는 목록 조회 수의 최대값이다.
+	// API 서버가 429로 요청을 거부하면 자동으로 줄였다가 성공이 이어지면 다시 늘린다.
+	MaxInFlight int
+
+	// CacheDir가 있으면 discovery 결과와 네임스페이스 목록을 <CacheDir>/<서버 주소>/에 저장해 다음 실행에서 재사용한다
+	CacheDir          string
+	DiscoveryCacheTTL time.Duration
+	NamespaceCacheTTL time.Duration
+	// RefreshCache이면 저장된 캐시를 읽지 않고 새로 조회한 결과로 덮어쓴다
+	RefreshCache bool
+}
+
+func (c *ClientConfig) applyDefaults() {
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.MaxAttempts < 1 {
+		c.MaxAttempts = 1
+	}
+	if c.BackoffBase <= 0 {
+		c.BackoffBase = DefaultBackoffBase
+	}
+	if c.BackoffMax <= 0 {
+		c.BackoffMax = DefaultBackoffMax
+	}
+	if c.BackoffMax < c.BackoffBase {
+		c.BackoffMax = c.BackoffBase
+	}
+	if c.QPS == 0 {
+		c.QPS = DefaultQPS
+	}
+	if c.Burst <= 0 {
+		c.Burst = DefaultBurst
+	}
+	if c.MaxInFlight <= 0 {
+		c.MaxInFlight = DefaultMaxInFlight
+	}
+	if c.DiscoveryCacheTTL <= 0 {
+		c.DiscoveryCacheTTL = DefaultDiscoveryCacheTTL
+	}
+	if c.NamespaceCacheTTL <= 0 {
+		c.NamespaceCacheTTL = DefaultNamespaceCacheTTL
+	}
+}
+
+func (c *ClientConfig) backoffDelay(attempt int) time.Duration {
+	delay := c.BackoffBase
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if delay >= c.BackoffMax {
+			return c.BackoffMax
+		}
+	}
+	return delay
 }
 
 type Client struct {
 	config                     *ClientConfig
 	clientset                  kubernetes.Interface
 	dynamicClient              dynamic.Interface
+	metadataClient             metadata.Interface
 	discoveryClient            discovery.DiscoveryInterface
+	limiter                    *adaptiveLimiter
+	server                     string
+	cache                      *diskCache
 	cachedResourceTypes        []metav1.APIResource
+	cachedResourceTypesErr     error
 	cachedResourceTypesOnce    sync.Once
 	cachedAPIResourceLists     []*metav1.APIResourceList
+	cachedAPIResourceListsErr  error
 	cachedAPIResourceListsOnce sync.Once
+	discoveryFailures          []*k8sinterface.ResourceTypeError
 	cachedNamespaces           []string
 	cachedNamespacesTime       time.Time
 	namespacesMutex            sync.RWMutex
@@ -50,6 +148,8 @@ func init() {
 }
 
 func NewClient(cfg *ClientConfig) (*Client, error) {
+	cfg.applyDefaults()
+
 	kubeconfig := filepath.Join(homedir.HomeDir(), ".kube", "config")
 	if kubeconfigEnv := clientcmd.NewDefaultClientConfigLoadingRules().ExplicitPath; kubeconfigEnv != "" {
 		kubeconfig = kubeconfigEnv
@@ -63,13 +163,22 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 		}
 	}
 
-	restConfig.QPS = 0
-	restConfig.Burst = 0
-	restConfig.Timeout = 60 * time.Second
+	restConfig.QPS = cfg.QPS
+	restConfig.Burst = cfg.Burst
+	restConfig.Timeout = cfg.Timeout
+
+	// clientset, dynamic, metadata client가 같은 토큰 버킷을 공유해야 전체 요청률이 제한된다
+	if cfg.QPS < 0 {
+		restConfig.RateLimiter = flowcontrol.NewFakeAlwaysRateLimiter()
+	} else {
+		restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(cfg.QPS, cfg.Burst)
+	}
 
-	restConfig.RateLimiter = flowcontrol.NewFakeAlwaysRateLimiter()
+	limiter := newAdaptiveLimiter(cfg.MaxInFlight)
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &throttleObserver{next: rt, limiter: limiter}
+	})
 
-	restConfig.DisableCompression = true
 	restConfig.UserAgent = "argus/1.0"
 
 	clientset, err := kubernetes.NewForConfig(restConfig)
@@ -82,14 +191,41 @@ func NewClient(cfg *ClientConfig) (*Client, error) {
 		return nil, fmt.Errorf("dynamic client 생성 실패: %w", err)
 	}
 
+	metadataClient, err := metadata.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("metadata client 생성 실패: %w", err)
+	}
+
 	return &Client{
 		config:          cfg,
 		clientset:       clientset,
 		dynamicClient:   dynamicClient,
+		metadataClient:  metadataClient,
 		discoveryClient: clientset.Discovery(),
+		limiter:         limiter,
+		server:          restConfig.Host,
+		cache:           newDiskCache(cfg.CacheDir, restConfig.Host),
 	}, nil
 }
 
+// Dynamic은 조회 외의 작업(argus remediate 등)에 사용할 dynamic client를 반환한다
+func (c *Client) Dynamic() dynamic.Interface {
+	return c.dynamicClient
+}
+
+// ThrottleStats는 현재 동시 목록 조회 한도와 지금까지 API 서버에서 받은 429 응답 수를 반환한다
+func (c *Client) ThrottleStats() (limit, throttled int) {
+	if c.limiter == nil {
+		return 0, 0
+	}
+	return c.limiter.Stats()
+}
+
+// RESTMapper는 apiVersion/kind를 리소스(GVR)와 범위로 변환하는 discovery 기반 매퍼를 반환한다
+func (c *Client) RESTMapper() meta.RESTMapper {
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.discoveryClient))
+}
+
 func (c *Client) GetCurrentContext() (string, string) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
@@ -106,22 +242,39 @@ func (c *Client) GetCurrentContext() (string, string) {
 	return context, ""
 }
 
-func (c *Client) GetAllNamespaces() ([]string, error) {
-	c.namespacesMutex.RLock()
-	if time.Since(c.cachedNamespacesTime) < 5*time.Minute && len(c.cachedNamespaces) > 0 {
-		namespaces := make([]string, len(c.cachedNamespaces))
-		copy(namespaces, c.cachedNamespaces)
+func (c *Client) GetAllNamespaces(ctx context.Context) ([]string, error) {
+	namespaces, _, err := c.listNamespaces(ctx, true)
+	return namespaces, err
+}
+
+// listNamespaces는 useCache이면 메모리, 디스크 캐시 순으로 확인하고 없으면 API 서버에서 조회한다.
+// 두 번째 반환값은 캐시에서 가져온 결과인지 여부이다.
+func (c *Client) listNamespaces(ctx context.Context, useCache bool) ([]string, bool, error) {
+	if useCache {
+		c.namespacesMutex.RLock()
+		if time.Since(c.cachedNamespacesTime) < c.config.NamespaceCacheTTL && len(c.cachedNamespaces) > 0 {
+			namespaces := make([]string, len(c.cachedNamespaces))
+			copy(namespaces, c.cachedNamespaces)
+			c.namespacesMutex.RUnlock()
+			return namespaces, true, nil
+		}
 		c.namespacesMutex.RUnlock()
-		return namespaces, nil
+
+		var namespaces []string
+		if !c.config.RefreshCache {
+			if savedAt, ok := c.cache.load(namespaceCacheFile, c.config.NamespaceCacheTTL, &namespaces); ok && len(namespaces) > 0 {
+				c.storeNamespaces(namespaces, savedAt)
+				return namespaces, true, nil
+			}
+		}
 	}
-	c.namespacesMutex.RUnlock()
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
 
 	nsList, err := c.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	var namespaces []string
@@ -129,44 +282,121 @@ func (c *Client) GetAllNamespaces() ([]string, error) {
 		namespaces = append(namespaces, ns.Name)
 	}
 
+	c.storeNamespaces(namespaces, time.Now())
+	if err := c.cache.save(namespaceCacheFile, c.server, namespaces); err != nil {
+		klog.V(2).Infof("네임스페이스 캐시 저장 실패: %v", err)
+	}
+
+	return namespaces, false, nil
+}
+
+func (c *Client) storeNamespaces(namespaces []string, savedAt time.Time) {
 	c.namespacesMutex.Lock()
 	c.cachedNamespaces = namespaces
-	c.cachedNamespacesTime = time.Now()
+	c.cachedNamespacesTime = savedAt
 	c.namespacesMutex.Unlock()
-
-	return namespaces, nil
 }
 
 func (c *Client) getAPIResourceLists() ([]*metav1.APIResourceList, error) {
-	var err error
 	c.cachedAPIResourceListsOnce.Do(func() {
-		_, apiResourceLists, e := c.discoveryClient.ServerGroupsAndResources()
-		if e != nil {
-			err = e
-			return
+		c.cachedAPIResourceLists, c.cachedAPIResourceListsErr = c.discoverAPIResourceLists()
+	})
+
+	return c.cachedAPIResourceLists, c.cachedAPIResourceListsErr
+}
+
+// discoverAPIResourceLists는 디스크 캐시가 유효하면 사용하고, 없으면 discovery를 호출한다.
+// 집계 API(metrics.k8s.io 등) 일부만 응답하지 않으면 나머지 그룹으로 계속 진행하며,
+// 이 경우 다음 실행에서 다시 조회하도록 결과를 디스크에 저장하지 않는다.
+func (c *Client) discoverAPIResourceLists() ([]*metav1.APIResourceList, error) {
+	var apiResourceLists []*metav1.APIResourceList
+	if !c.config.RefreshCache {
+		if _, ok := c.cache.load(discoveryCacheFile, c.config.DiscoveryCacheTTL, &apiResourceLists); ok {
+			return apiResourceLists, nil
+		}
+	}
+
+	_, apiResourceLists, err := c.discoveryClient.ServerGroupsAndResources()
+	if err != nil {
+		var groupErr *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &groupErr) || len(apiResourceLists) == 0 {
+			return nil, err
 		}
-		c.cachedAPIResourceLists = apiResourceLists
+		c.discoveryFailures = discoveryFailures(groupErr)
+		return apiResourceLists, nil
+	}
+
+	if err := c.cache.save(discoveryCacheFile, c.server, apiResourceLists); err != nil {
+		klog.V(2).Infof("discovery 캐시 저장 실패: %v", err)
+	}
+	return apiResourceLists, nil
+}
+
+func discoveryFailures(err *discovery.ErrGroupDiscoveryFailed) []*k8sinterface.ResourceTypeError {
+	failures := make([]*k8sinterface.ResourceTypeError, 0, len(err.Groups))
+	for gv, groupErr := range err.Groups {
+		failures = append(failures, &k8sinterface.ResourceTypeError{ResourceType: gv.String(), Reason: k8sinterface.FailureDiscovery, Err: groupErr})
+	}
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].ResourceType < failures[j].ResourceType
 	})
+	return failures
+}
 
-	return c.cachedAPIResourceLists, err
+// DiscoveryFailures는 discovery에 실패해 스캔하지 못한 API 그룹과 오류를 반환한다
+func (c *Client) DiscoveryFailures() []*k8sinterface.ResourceTypeError {
+	if _, err := c.getAPIResourceLists(); err != nil {
+		return nil
+	}
+	return c.discoveryFailures
 }
 
-func (c *Client) GetResourceTypes(namespaced bool) ([]string, error) {
+func (c *Client) GetResourceTypes(ctx context.Context, namespaced bool) ([]string, error) {
+	resources, err := c.listableResourceTypes()
+	if err != nil {
+		return nil, err
+	}
+
 	if len(c.config.ImportantResourceTypes) > 0 {
-		return c.config.ImportantResourceTypes, nil
+		return servedImportantTypes(c.config.ImportantResourceTypes, resources, namespaced), nil
 	}
 
-	var err error
+	return resourceTypeNames(resources, func(r metav1.APIResource) bool {
+		return !namespaced || r.Namespaced
+	}), nil
+}
+
+// GetClusterResourceTypes는 빠른 스캔 모드에서도 discovery 결과를 사용한다.
+// 중요 리소스 목록은 네임스페이스 리소스 기준이라 클러스터 범위 타입을 구분할 수 없기 때문이다.
+func (c *Client) GetClusterResourceTypes(ctx context.Context) ([]string, error) {
+	resources, err := c.listableResourceTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceTypeNames(resources, func(r metav1.APIResource) bool {
+		return !r.Namespaced
+	}), nil
+}
+
+// listableResourceTypes는 list를 지원하고 스킵 대상이 아닌 모든 리소스 타입을 범위와 관계없이 캐시한다
+func (c *Client) listableResourceTypes() ([]metav1.APIResource, error) {
 	c.cachedResourceTypesOnce.Do(func() {
-		apiResourceLists, e := c.getAPIResourceLists()
-		if e != nil {
-			err = e
+		apiResourceLists, err := c.getAPIResourceLists()
+		if err != nil {
+			c.cachedResourceTypesErr = err
 			return
 		}
 
 		var resources []metav1.APIResource
 		for _, apiResourceList := range apiResourceLists {
+			gv, _ := schema.ParseGroupVersion(apiResourceList.GroupVersion)
 			for _, apiResource := range apiResourceList.APIResources {
+				// discovery 응답의 APIResource에는 그룹이 비어 있으므로 목록의 GroupVersion으로 채운다
+				if apiResource.Group == "" {
+					apiResource.Group = gv.Group
+				}
+
 				hasListVerb := false
 				for _, verb := range apiResource.Verbs {
 					if verb == "list" {
@@ -178,10 +408,6 @@ func (c *Client) GetResourceTypes(namespaced bool) ([]string, error) {
 					continue
 				}
 
-				if namespaced && !apiResource.Namespaced {
-					continue
-				}
-
 				resourceName := apiResource.Name
 				if apiResource.Group != "" {
 					resourceName = fmt.Sprintf("%s.%s", apiResource.Name, apiResource.Group)
@@ -196,12 +422,69 @@ func (c *Client) GetResourceTypes(namespaced bool) ([]string, error) {
 		c.cachedResourceTypes = resources
 	})
 
+	return c.cachedResourceTypes, c.cachedResourceTypesErr
+}
+
+// GetServedResourceTypes는 list 지원 여부나 스킵 설정과 관계없이 서버가 제공하는 모든 리소스 타입 이름을 반환한다.
+// 설정 파일의 타입 이름은 그룹 없이 쓸 수도 있으므로 그룹이 있는 타입은 "plural.group"과 "plural"을 모두 포함한다.
+func (c *Client) GetServedResourceTypes(ctx context.Context) ([]string, error) {
+	apiResourceLists, err := c.getAPIResourceLists()
 	if err != nil {
 		return nil, err
 	}
 
+	served := make(map[string]bool)
+	for _, apiResourceList := range apiResourceLists {
+		gv, _ := schema.ParseGroupVersion(apiResourceList.GroupVersion)
+		for _, apiResource := range apiResourceList.APIResources {
+			// pods/log 같은 하위 리소스는 타입으로 지정할 수 없다
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			served[apiResource.Name] = true
+			if gv.Group != "" {
+				served[apiResource.Name+"."+gv.Group] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(served))
+	for name := range served {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// servedImportantTypes는 중요 리소스 목록 중 서버가 제공하는 타입만 남긴다.
+// Istio CRD처럼 설치되지 않은 타입을 조회하면 not_found 실패로 기록되어 모든 네임스페이스가 불완전한 스캔이 되기 때문이다.
+func servedImportantTypes(important []string, resources []metav1.APIResource, namespaced bool) []string {
+	served := make(map[string]bool)
+	for _, r := range resources {
+		if namespaced && !r.Namespaced {
+			continue
+		}
+		served[r.Name] = true
+		if r.Group != "" {
+			served[r.Name+"."+r.Group] = true
+		}
+	}
+
+	var resourceTypes []string
+	for _, resourceType := range important {
+		if served[resourceType] {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return resourceTypes
+}
+
+func resourceTypeNames(resources []metav1.APIResource, include func(metav1.APIResource) bool) []string {
 	resourceMap := make(map[string]bool)
-	for _, r := range c.cachedResourceTypes {
+	for _, r := range resources {
+		if !include(r) {
+			continue
+		}
 		if r.Group != "" {
 			resourceMap[fmt.Sprintf("%s.%s", r.Name, r.Group)] = true
 		} else {
@@ -214,11 +497,12 @@ func (c *Client) GetResourceTypes(namespaced bool) ([]string, error) {
 		resourceNames = append(resourceNames, name)
 	}
 
-	return resourceNames, nil
+	return resourceNames
 }
 
-func (c *Client) GetResourcesBatch(resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+func (c *Client) GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
 	var allResources []map[string]interface{}
+	var failures []*k8sinterface.ResourceTypeError
 	var mu sync.Mutex
 	var wg sync.WaitGroup
 
@@ -246,8 +530,18 @@ func (c *Client) GetResourcesBatch(resourceTypes []string, namespace string) ([]
 		go func() {
 			defer wg.Done()
 			for resourceType := range workChan {
-				resources, err := c.GetResources(resourceType, namespace)
+				if ctx.Err() != nil {
+					mu.Lock()
+					failures = append(failures, canceledError(resourceType, ctx.Err()))
+					mu.Unlock()
+					continue
+				}
+
+				resources, err := c.GetResources(ctx, resourceType, namespace)
 				if err != nil {
+					mu.Lock()
+					failures = append(failures, toResourceTypeError(resourceType, err))
+					mu.Unlock()
 					continue
 				}
 				if len(resources) > 0 {
@@ -289,92 +583,213 @@ func (c *Client) GetResourcesBatch(resourceTypes []string, namespace string) ([]
 	}
 
 	wg.Wait()
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool {
+			return failures[i].ResourceType < failures[j].ResourceType
+		})
+		return allResources, &k8sinterface.BatchError{Failures: failures}
+	}
 	return allResources, nil
 }
 
-func (c *Client) GetResources(resourceType, namespace string) ([]map[string]interface{}, error) {
+func toResourceTypeError(resourceType string, err error) *k8sinterface.ResourceTypeError {
+	var rtErr *k8sinterface.ResourceTypeError
+	if errors.As(err, &rtErr) {
+		return rtErr
+	}
+	return &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: classifyError(err), Err: err}
+}
+
+func (c *Client) GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error) {
 	cacheKey := fmt.Sprintf("%s:%s", namespace, resourceType)
 	if empty, ok := c.emptyResourceCache.Load(cacheKey); ok && empty.(bool) {
 		return nil, nil
 	}
 
-	var gvr schema.GroupVersionResource
-
 	apiResource, gv, err := c.findAPIResource(resourceType)
 	if err != nil {
-		return nil, nil
+		return nil, &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: k8sinterface.FailureNotFound, Err: err}
 	}
+	gvr := gv.WithResource(apiResource.Name)
 
-	gvr.Resource = apiResource.Name
-	gvr.Group = gv.Group
-	gvr.Version = gv.Version
-
-	var list *unstructured.UnstructuredList
-	maxRetries := 3
-	retryDelay := time.Second
+	var resources []map[string]interface{}
+	maxAttempts := max(c.config.MaxAttempts, 1)
 
-	for i := 0; i < maxRetries; i++ {
-		timeout := time.Duration(20+i*10) * time.Second
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	for i := 0; i < maxAttempts; i++ {
+		if err := c.limiter.Acquire(ctx); err != nil {
+			return nil, canceledError(resourceType, err)
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 
 		listOpts := metav1.ListOptions{
 			Limit: 500,
 		}
 
-		var allItems []unstructured.Unstructured
+		var allItems []map[string]interface{}
 		for {
-			tempList, tempErr := c.dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, listOpts)
-			if tempErr != nil {
-				err = tempErr
+			items, continueToken, listErr := c.listPage(attemptCtx, gvr, apiResource.Kind, namespace, listOpts)
+			if listErr != nil {
+				err = listErr
 				break
 			}
 
-			allItems = append(allItems, tempList.Items...)
+			allItems = append(allItems, items...)
 
-			if tempList.GetContinue() == "" {
-				list = &unstructured.UnstructuredList{
-					Object: tempList.Object,
-					Items:  allItems,
-				}
+			if continueToken == "" {
+				resources = allItems
 				err = nil
 				break
 			}
 
-			listOpts.Continue = tempList.GetContinue()
+			listOpts.Continue = continueToken
 		}
 
+		timedOut := attemptCtx.Err() == context.DeadlineExceeded
 		cancel()
+		c.limiter.Release()
 
 		if err == nil {
+			c.limiter.Success()
 			break
 		}
 
-		if ctx.Err() != nil || strings.Contains(err.Error(), "timeout") ||
-			strings.Contains(err.Error(), "deadline exceeded") ||
-			strings.Contains(err.Error(), "connection reset") ||
-			strings.Contains(err.Error(), "temporary") {
-			if i < maxRetries-1 {
-				time.Sleep(retryDelay)
-				retryDelay *= 2
+		if ctx.Err() != nil {
+			return nil, canceledError(resourceType, ctx.Err())
+		}
+
+		// 429 응답은 throttleObserver가 전송 단계에서 이미 한도에 반영했으므로 여기서 다시 세지 않는다
+		if timedOut || isRetryableError(err) {
+			if i < maxAttempts-1 {
+				if err := sleepWithContext(ctx, c.retryDelay(i, err)); err != nil {
+					return nil, canceledError(resourceType, err)
+				}
 				continue
 			}
-			return nil, nil
+			reason := k8sinterface.FailureTimeout
+			if apierrors.IsTooManyRequests(err) {
+				reason = k8sinterface.FailureThrottled
+			}
+			return nil, &k8sinterface.ResourceTypeError{
+				ResourceType: resourceType,
+				Reason:       reason,
+				Err:          fmt.Errorf("%d회 시도 후 실패: %w", maxAttempts, err),
+			}
 		}
 
-		return nil, err
+		return nil, &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: classifyError(err), Err: err}
 	}
 
-	if len(list.Items) == 0 {
+	if len(resources) == 0 {
 		c.emptyResourceCache.Store(cacheKey, true)
 		return nil, nil
 	}
 
-	var resources []map[string]interface{}
+	return resources, nil
+}
+
+// listPage는 목록 한 페이지를 조회해 객체와 다음 페이지의 continue 토큰을 반환한다.
+// MetadataOnly이면 metadata client로 조회하므로 객체 본문은 전송되지 않는다.
+func (c *Client) listPage(ctx context.Context, gvr schema.GroupVersionResource, kind, namespace string, opts metav1.ListOptions) ([]map[string]interface{}, string, error) {
+	if c.config.MetadataOnly {
+		list, err := c.metadataClient.Resource(gvr).Namespace(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]map[string]interface{}, 0, len(list.Items))
+		for i := range list.Items {
+			item, err := partialObjectToMap(&list.Items[i], gvr.GroupVersion(), kind)
+			if err != nil {
+				return nil, "", err
+			}
+			items = append(items, item)
+		}
+		return items, list.Continue, nil
+	}
+
+	list, err := c.dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	items := make([]map[string]interface{}, 0, len(list.Items))
 	for _, item := range list.Items {
-		resources = append(resources, item.Object)
+		items = append(items, item.Object)
 	}
+	return items, list.GetContinue(), nil
+}
 
-	return resources, nil
+// partialObjectToMap은 PartialObjectMetadata를 dynamic client 결과와 같은 형태로 바꾼다.
+// 목록 항목의 TypeMeta는 meta.k8s.io/v1 PartialObjectMetadata이므로 실제 apiVersion/kind로 채운다.
+func partialObjectToMap(item *metav1.PartialObjectMetadata, gv schema.GroupVersion, kind string) (map[string]interface{}, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&item.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("메타데이터 변환 실패 (%s): %w", item.Name, err)
+	}
+	return map[string]interface{}{
+		"apiVersion": gv.String(),
+		"kind":       kind,
+		"metadata":   object,
+	}, nil
+}
+
+func canceledError(resourceType string, err error) *k8sinterface.ResourceTypeError {
+	return &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: k8sinterface.FailureCanceled, Err: err}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryDelay는 지수 백오프 대기 시간과 서버가 Retry-After로 요청한 대기 시간 중 긴 쪽을 사용한다
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	delay := c.config.backoffDelay(attempt)
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+		if suggested := time.Duration(seconds) * time.Second; suggested > delay {
+			return suggested
+		}
+	}
+	return delay
+}
+
+// isRetryableError는 같은 요청을 다시 보내면 성공할 수 있는 오류인지 판단한다.
+// 429(API Priority and Fairness 거부 포함), 503, 서버 타임아웃과 연결 끊김이 해당한다.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) ||
+		apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err) {
+		return true
+	}
+	if _, ok := apierrors.SuggestsClientDelay(err); ok {
+		return true
+	}
+	if utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func classifyError(err error) string {
+	switch {
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return k8sinterface.FailureForbidden
+	case apierrors.IsNotFound(err), apierrors.IsMethodNotSupported(err):
+		return k8sinterface.FailureNotFound
+	case apierrors.IsTooManyRequests(err):
+		return k8sinterface.FailureThrottled
+	case isRetryableError(err):
+		return k8sinterface.FailureTimeout
+	default:
+		return k8sinterface.FailureError
+	}
 }
 
 func (c *Client) findAPIResource(resourceType string) (*metav1.APIResource, *schema.GroupVersion, error) {
@@ -400,12 +815,25 @@ func (c *Client) findAPIResource(resourceType string) (*metav1.APIResource, *sch
 	return nil, nil, fmt.Errorf("리소스 타입을 찾을 수 없음: %s", resourceType)
 }
 
-func (c *Client) ValidateNamespacesBatch(namespaces []string) (map[string]bool, error) {
-	allNamespaces, err := c.GetAllNamespaces()
+func (c *Client) ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error) {
+	allNamespaces, cached, err := c.listNamespaces(ctx, true)
 	if err != nil {
 		return nil, err
 	}
 
+	result := existingNamespaces(allNamespaces, namespaces)
+	// 캐시에 없는 네임스페이스는 캐시 저장 이후 생성되었을 수 있으므로 API 서버에서 다시 확인한다
+	if cached && containsFalse(result) {
+		if allNamespaces, _, err = c.listNamespaces(ctx, false); err != nil {
+			return nil, err
+		}
+		result = existingNamespaces(allNamespaces, namespaces)
+	}
+
+	return result, nil
+}
+
+func existingNamespaces(allNamespaces, namespaces []string) map[string]bool {
 	existingNs := make(map[string]bool)
 	for _, ns := range allNamespaces {
 		existingNs[ns] = true
@@ -415,6 +843,14 @@ func (c *Client) ValidateNamespacesBatch(namespaces []string) (map[string]bool,
 	for _, ns := range namespaces {
 		result[ns] = existingNs[ns]
 	}
+	return result
+}
 
-	return result, nil
+func containsFalse(values map[string]bool) bool {
+	for _, v := range values {
+		if !v {
+			return true
+		}
+	}
+	return false
 }
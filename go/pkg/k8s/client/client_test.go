@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"syscall"
+	"testing"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakemetadata "k8s.io/client-go/metadata/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+func TestClientConfig_ApplyDefaults(t *testing.T) {
+	cfg := &ClientConfig{MaxAttempts: -1}
+	cfg.applyDefaults()
+
+	if cfg.Timeout != DefaultTimeout {
+		t.Errorf("Timeout = %v, want %v", cfg.Timeout, DefaultTimeout)
+	}
+	if cfg.MaxAttempts != 1 {
+		t.Errorf("MaxAttempts = %v, want 1", cfg.MaxAttempts)
+	}
+	if cfg.BackoffBase != DefaultBackoffBase {
+		t.Errorf("BackoffBase = %v, want %v", cfg.BackoffBase, DefaultBackoffBase)
+	}
+	if cfg.BackoffMax != DefaultBackoffMax {
+		t.Errorf("BackoffMax = %v, want %v", cfg.BackoffMax, DefaultBackoffMax)
+	}
+	if cfg.QPS != DefaultQPS || cfg.Burst != DefaultBurst || cfg.MaxInFlight != DefaultMaxInFlight {
+		t.Errorf("QPS/Burst/MaxInFlight = %v/%v/%v, want %v/%v/%v", cfg.QPS, cfg.Burst, cfg.MaxInFlight, DefaultQPS, DefaultBurst, DefaultMaxInFlight)
+	}
+
+	unlimited := &ClientConfig{QPS: -1}
+	unlimited.applyDefaults()
+	if unlimited.QPS != -1 {
+		t.Errorf("QPS = %v, want -1 (제한 없음 유지)", unlimited.QPS)
+	}
+}
+
+func TestClientConfig_BackoffDelay(t *testing.T) {
+	cfg := &ClientConfig{
+		BackoffBase: 500 * time.Millisecond,
+		BackoffMax:  3 * time.Second,
+	}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: 500 * time.Millisecond},
+		{attempt: 1, want: time.Second},
+		{attempt: 2, want: 2 * time.Second},
+		{attempt: 3, want: 3 * time.Second},
+		{attempt: 10, want: 3 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := cfg.backoffDelay(tt.attempt); got != tt.want {
+			t.Errorf("backoffDelay(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	secrets := schema.GroupResource{Resource: "secrets"}
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "권한 없음", err: apierrors.NewForbidden(secrets, "", errors.New("denied")), want: k8sinterface.FailureForbidden},
+		{name: "인증 실패", err: apierrors.NewUnauthorized("unauthorized"), want: k8sinterface.FailureForbidden},
+		{name: "리소스 없음", err: apierrors.NewNotFound(secrets, "x"), want: k8sinterface.FailureNotFound},
+		{name: "서버 타임아웃", err: apierrors.NewTimeoutError("slow", 1), want: k8sinterface.FailureTimeout},
+		{name: "컨텍스트 타임아웃", err: fmt.Errorf("list: %w", context.DeadlineExceeded), want: k8sinterface.FailureTimeout},
+		{name: "요청 제한", err: apierrors.NewTooManyRequests("priority level exhausted", 3), want: k8sinterface.FailureThrottled},
+		{name: "서비스 불가", err: apierrors.NewServiceUnavailable("unavailable"), want: k8sinterface.FailureTimeout},
+		{name: "연결 끊김", err: fmt.Errorf("list: %w", syscall.ECONNRESET), want: k8sinterface.FailureTimeout},
+		{name: "기타 에러", err: errors.New("boom"), want: k8sinterface.FailureError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.want {
+				t.Errorf("classifyError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_RetryDelay(t *testing.T) {
+	c := &Client{config: &ClientConfig{BackoffBase: time.Second, BackoffMax: 30 * time.Second}}
+
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{name: "Retry-After가 더 긴 경우", err: apierrors.NewTooManyRequests("throttled", 5), want: 5 * time.Second},
+		{name: "백오프가 더 긴 경우", err: apierrors.NewTooManyRequests("throttled", 1), want: 2 * time.Second},
+		{name: "Retry-After 없음", err: errors.New("boom"), want: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.retryDelay(1, tt.err); got != tt.want {
+				t.Errorf("retryDelay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_ResourceTypesByScope(t *testing.T) {
+	listVerbs := metav1.Verbs{"get", "list"}
+	discovery := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Namespaced: true, Verbs: listVerbs},
+				{Name: "namespaces", Namespaced: false, Verbs: listVerbs},
+				{Name: "nodes", Namespaced: false, Verbs: listVerbs},
+				{Name: "bindings", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+			},
+		},
+		{
+			GroupVersion: "rbac.authorization.k8s.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "clusterroles", Namespaced: false, Verbs: listVerbs},
+				{Name: "roles", Namespaced: true, Verbs: listVerbs},
+			},
+		},
+	}}}
+
+	c := &Client{
+		config:          &ClientConfig{SkipResourceTypes: map[string]bool{"nodes": true, "roles.rbac.authorization.k8s.io": true}},
+		discoveryClient: discovery,
+	}
+
+	namespaced, err := c.GetResourceTypes(context.Background(), true)
+	if err != nil {
+		t.Fatalf("GetResourceTypes() error = %v", err)
+	}
+	sort.Strings(namespaced)
+	if want := []string{"configmaps"}; !reflect.DeepEqual(namespaced, want) {
+		t.Errorf("GetResourceTypes(true) = %v, want %v", namespaced, want)
+	}
+
+	served, err := c.GetServedResourceTypes(context.Background())
+	if err != nil {
+		t.Fatalf("GetServedResourceTypes() error = %v", err)
+	}
+	want := []string{"bindings", "clusterroles", "clusterroles.rbac.authorization.k8s.io", "configmaps", "namespaces", "nodes", "roles", "roles.rbac.authorization.k8s.io"}
+	if !reflect.DeepEqual(served, want) {
+		t.Errorf("GetServedResourceTypes() = %v, want %v", served, want)
+	}
+
+	c.config.ImportantResourceTypes = []string{"configmaps", "gateways.networking.istio.io", "namespaces"}
+	important, err := c.GetResourceTypes(context.Background(), true)
+	if err != nil {
+		t.Fatalf("GetResourceTypes() error = %v", err)
+	}
+	if want := []string{"configmaps"}; !reflect.DeepEqual(important, want) {
+		t.Errorf("빠른 스캔 GetResourceTypes(true) = %v, want %v (서버에 없는 타입과 클러스터 범위 타입 제외)", important, want)
+	}
+
+	cluster, err := c.GetClusterResourceTypes(context.Background())
+	if err != nil {
+		t.Fatalf("GetClusterResourceTypes() error = %v", err)
+	}
+	sort.Strings(cluster)
+	if want := []string{"clusterroles.rbac.authorization.k8s.io", "namespaces"}; !reflect.DeepEqual(cluster, want) {
+		t.Errorf("GetClusterResourceTypes() = %v, want %v", cluster, want)
+	}
+}
+
+func TestClient_GetResourcesMetadataOnly(t *testing.T) {
+	discovery := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			},
+		},
+	}}}
+
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	configMap := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "app-config",
+			Namespace:   "default",
+			UID:         "1234",
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{"owner": "sre"},
+		},
+	}
+
+	c := &Client{
+		config:          &ClientConfig{Timeout: DefaultTimeout, MetadataOnly: true},
+		metadataClient:  fakemetadata.NewSimpleMetadataClient(scheme, configMap),
+		discoveryClient: discovery,
+	}
+
+	resources, err := c.GetResources(context.Background(), "configmaps", "default")
+	if err != nil {
+		t.Fatalf("GetResources() error = %v", err)
+	}
+	if len(resources) != 1 {
+		t.Fatalf("GetResources() = %d개, want 1개", len(resources))
+	}
+
+	got := resources[0]
+	if got["apiVersion"] != "v1" || got["kind"] != "ConfigMap" {
+		t.Errorf("apiVersion/kind = %v/%v, want v1/ConfigMap", got["apiVersion"], got["kind"])
+	}
+	metadata := got["metadata"].(map[string]interface{})
+	if metadata["name"] != "app-config" || metadata["uid"] != "1234" {
+		t.Errorf("metadata = %v", metadata)
+	}
+	if labels := metadata["labels"].(map[string]interface{}); labels["app"] != "web" {
+		t.Errorf("labels = %v, want app=web", labels)
+	}
+}
+
+func TestClient_GetResourcesMaxAttempts(t *testing.T) {
+	discovery := &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: metav1.Verbs{"list"}},
+			},
+		},
+	}}}
+
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	metadataClient := fakemetadata.NewSimpleMetadataClient(scheme)
+	attempts := 0
+	metadataClient.PrependReactor("list", "configmaps", func(kubetesting.Action) (bool, runtime.Object, error) {
+		attempts++
+		return true, nil, apierrors.NewServiceUnavailable("busy")
+	})
+
+	cfg := &ClientConfig{MaxAttempts: 3, MetadataOnly: true, BackoffBase: time.Millisecond}
+	cfg.applyDefaults()
+	c := &Client{config: cfg, metadataClient: metadataClient, discoveryClient: discovery}
+
+	_, err := c.GetResources(context.Background(), "configmaps", "default")
+	var typeErr *k8sinterface.ResourceTypeError
+	if !errors.As(err, &typeErr) || typeErr.Reason != k8sinterface.FailureTimeout {
+		t.Fatalf("GetResources() error = %v, want timeout ResourceTypeError", err)
+	}
+	if attempts != 3 {
+		t.Errorf("시도 횟수 = %d, want 3 (--retry는 첫 시도를 포함한 횟수)", attempts)
+	}
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// throttleCooldown 동안에는 동시 요청 수를 한 번만 줄인다.
+// 동시에 진행 중이던 요청들이 같은 과부하 때문에 함께 429를 받는 경우 한 번의 신호로 본다.
+const throttleCooldown = time.Second
+
+// adaptiveLimiter는 목록 조회 동시 요청 수를 AIMD 방식으로 조절한다.
+// API 서버가 429(API Priority and Fairness 거부 포함)로 밀어내면 한도를 절반으로 줄이고,
+// 현재 한도만큼 연속으로 성공하면 한도를 하나씩 늘려 최대값까지 회복한다.
+// nil이면 제한하지 않는다.
+type adaptiveLimiter struct {
+	mu           sync.Mutex
+	max          int
+	limit        int
+	inFlight     int
+	successes    int
+	throttled    int
+	lastDecrease time.Time
+	// changed는 한도나 진행 중인 요청 수가 바뀔 때 닫혀 대기 중인 요청을 깨운다
+	changed chan struct{}
+}
+
+func newAdaptiveLimiter(max int) *adaptiveLimiter {
+	return &adaptiveLimiter{max: max, limit: max, changed: make(chan struct{})}
+}
+
+func (l *adaptiveLimiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		if l.inFlight < l.limit {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (l *adaptiveLimiter) Release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	l.inFlight--
+	l.notifyLocked()
+	l.mu.Unlock()
+}
+
+func (l *adaptiveLimiter) Success() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.successes++
+	if l.successes >= l.limit && l.limit < l.max {
+		l.limit++
+		l.successes = 0
+		l.notifyLocked()
+	}
+}
+
+func (l *adaptiveLimiter) Throttled() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.throttled++
+	l.successes = 0
+	if time.Since(l.lastDecrease) < throttleCooldown {
+		return
+	}
+	l.lastDecrease = time.Now()
+	if l.limit > 1 {
+		l.limit /= 2
+	}
+}
+
+// Stats는 현재 동시 요청 한도와 지금까지 받은 429 응답 수를 반환한다
+func (l *adaptiveLimiter) Stats() (limit, throttled int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit, l.throttled
+}
+
+func (l *adaptiveLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// throttleObserver는 모든 429 응답을 전송 단계에서 한 번씩 한도에 반영한다.
+// client-go가 내부에서 Retry-After를 따라 재시도해 호출자에게 보이지 않는 429도 포함되므로 호출자는 따로 세지 않는다.
+type throttleObserver struct {
+	next    http.RoundTripper
+	limiter *adaptiveLimiter
+}
+
+func (t *throttleObserver) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		t.limiter.Throttled()
+	}
+	return resp, err
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveLimiter(t *testing.T) {
+	l := newAdaptiveLimiter(8)
+
+	l.Throttled()
+	if limit, throttled := l.Stats(); limit != 4 || throttled != 1 {
+		t.Fatalf("Stats() = %d, %d, want 4, 1", limit, throttled)
+	}
+
+	// 같은 과부하로 동시에 받은 429는 한 번만 반영한다
+	l.Throttled()
+	if limit, _ := l.Stats(); limit != 4 {
+		t.Errorf("쿨다운 중 limit = %d, want 4", limit)
+	}
+
+	for i := 0; i < 4; i++ {
+		l.Success()
+	}
+	if limit, _ := l.Stats(); limit != 5 {
+		t.Errorf("연속 성공 후 limit = %d, want 5", limit)
+	}
+
+	l.lastDecrease = time.Time{}
+	for i := 0; i < 10; i++ {
+		l.lastDecrease = time.Time{}
+		l.Throttled()
+	}
+	if limit, _ := l.Stats(); limit != 1 {
+		t.Errorf("limit = %d, want 최소값 1", limit)
+	}
+}
+
+func TestAdaptiveLimiter_Acquire(t *testing.T) {
+	l := newAdaptiveLimiter(1)
+	if err := l.Acquire(context.Background()); err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(ctx); err == nil {
+		t.Fatal("한도를 넘은 Acquire()가 대기하지 않았습니다")
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- l.Acquire(context.Background()) }()
+	l.Release()
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Errorf("Release 후 Acquire() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Release 후에도 Acquire()가 대기 중입니다")
+	}
+}
+
+func TestAdaptiveLimiter_Nil(t *testing.T) {
+	var l *adaptiveLimiter
+	if err := l.Acquire(context.Background()); err != nil {
+		t.Errorf("Acquire() error = %v", err)
+	}
+	l.Release()
+	l.Success()
+	l.Throttled()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestThrottleObserver(t *testing.T) {
+	limiter := newAdaptiveLimiter(4)
+	statuses := []int{http.StatusTooManyRequests, http.StatusOK, http.StatusTooManyRequests}
+	observer := &throttleObserver{
+		limiter: limiter,
+		next: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			status := statuses[0]
+			statuses = statuses[1:]
+			return &http.Response{StatusCode: status}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	for i := 0; i < 3; i++ {
+		if _, err := observer.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, throttled := limiter.Stats(); throttled != 2 {
+		t.Errorf("throttled = %d, want 2 (429 응답 수)", throttled)
+	}
+}
@@ -0,0 +1,372 @@
+package dump
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Context는 덤프 분석 시 리포트에 표시되는 컨텍스트 이름이다
+const Context = "dump"
+
+type ClientConfig struct {
+	ImportantResourceTypes []string
+	SkipResourceTypes      map[string]bool
+}
+
+// Client는 kubectl get -A -o yaml 출력이나 Velero 백업 디렉토리 같은 매니페스트 덤프를
+// API 서버 대신 읽는 k8sinterface.K8sClient 구현이다
+type Client struct {
+	config *ClientConfig
+	path   string
+	// resourceType(plural 또는 plural.group) -> 객체 목록
+	objects    map[string][]map[string]interface{}
+	namespaced map[string]bool
+	namespaces map[string]bool
+	seen       map[string]bool
+	// discovery는 Recorder가 만든 스냅샷 아카이브에서 읽은 경우에만 있다
+	discovery *Discovery
+}
+
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// NewClient는 파일 하나, 디렉토리 트리 아래의 모든 YAML/JSON 파일, 또는 tar.gz 아카이브(--snapshot 결과, Velero 백업)를 읽는다.
+// 파일 하나에 여러 문서나 kind: List가 있어도 되며, UID가 같은 객체는 한 번만 사용한다.
+func NewClient(path string, cfg *ClientConfig) (*Client, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("덤프 경로를 찾을 수 없습니다: %w", err)
+	}
+
+	c := &Client{
+		config:     cfg,
+		path:       path,
+		objects:    make(map[string][]map[string]interface{}),
+		namespaced: make(map[string]bool),
+		namespaces: make(map[string]bool),
+		seen:       make(map[string]bool),
+	}
+
+	if isArchive(path) {
+		if err := c.loadArchive(path); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	if !info.IsDir() {
+		if err := c.loadFile(path); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	err = filepath.WalkDir(path, func(file string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !manifestExtensions[strings.ToLower(filepath.Ext(file))] {
+			return nil
+		}
+		return c.loadFile(file)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Client) loadFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("덤프 파일 읽기 실패: %w", err)
+	}
+	defer f.Close()
+
+	return c.load(f, file, "")
+}
+
+// load는 resourceType이 비어 있으면 각 객체의 apiVersion/kind에서 타입 이름을 추정한다
+func (c *Client) load(r io.Reader, source, resourceType string) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var doc map[string]interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("덤프 파일 파싱 실패 (%s): %w", source, err)
+		}
+		if doc == nil {
+			continue
+		}
+
+		if items, ok := doc["items"].([]interface{}); ok && strings.HasSuffix(getString(doc, "kind"), "List") {
+			for _, item := range items {
+				if obj, ok := item.(map[string]interface{}); ok {
+					c.add(obj, resourceType)
+				}
+			}
+			continue
+		}
+		c.add(doc, resourceType)
+	}
+}
+
+func (c *Client) add(obj map[string]interface{}, resourceType string) {
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if resourceType == "" {
+		resourceType = resourceTypeOf(obj)
+	}
+	if resourceType == "" {
+		return
+	}
+
+	// Velero 백업은 같은 객체를 여러 버전 디렉토리에 저장하므로 UID로 중복을 제거한다
+	key := objectKey(resourceType, obj)
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+
+	namespace := getString(metadata, "namespace")
+	c.objects[resourceType] = append(c.objects[resourceType], obj)
+	if namespace != "" {
+		c.namespaced[resourceType] = true
+		c.namespaces[namespace] = true
+	}
+	if getString(obj, "kind") == "Namespace" && getString(obj, "apiVersion") == "v1" {
+		c.namespaces[getString(metadata, "name")] = true
+	}
+}
+
+func isArchive(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz")
+}
+
+func (c *Client) loadArchive(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("덤프 아카이브 읽기 실패: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("덤프 아카이브 압축 해제 실패 (%s): %w", path, err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("덤프 아카이브 읽기 실패 (%s): %w", path, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		source := path + ":" + name
+		switch {
+		case name == discoveryFile:
+			var discovery Discovery
+			if err := json.NewDecoder(tr).Decode(&discovery); err != nil {
+				return fmt.Errorf("스냅샷 discovery 파싱 실패 (%s): %w", source, err)
+			}
+			c.discovery = &discovery
+		case strings.HasPrefix(name, objectsDir+"/") && strings.HasSuffix(name, ".json"):
+			resourceType := strings.TrimSuffix(strings.TrimPrefix(name, objectsDir+"/"), ".json")
+			if err := c.load(tr, source, resourceType); err != nil {
+				return err
+			}
+		case manifestExtensions[strings.ToLower(filepath.Ext(name))]:
+			if err := c.load(tr, source, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.applyDiscovery()
+	return nil
+}
+
+// applyDiscovery는 객체가 없던 타입과 네임스페이스까지 원래 스캔과 같게 복원한다
+func (c *Client) applyDiscovery() {
+	if c.discovery == nil {
+		return
+	}
+	for _, resourceType := range c.discovery.NamespacedResourceTypes {
+		c.namespaced[resourceType] = true
+		if _, ok := c.objects[resourceType]; !ok {
+			c.objects[resourceType] = nil
+		}
+	}
+	for _, resourceType := range c.discovery.ClusterResourceTypes {
+		if _, ok := c.objects[resourceType]; !ok {
+			c.objects[resourceType] = nil
+		}
+	}
+	for _, ns := range c.discovery.Namespaces {
+		c.namespaces[ns] = true
+	}
+}
+
+// resourceTypeOf는 discovery 없이 apiVersion/kind에서 API 클라이언트와 같은 형식(plural 또는 plural.group)의 타입 이름을 만든다
+func resourceTypeOf(obj map[string]interface{}) string {
+	kind := getString(obj, "kind")
+	gv, err := schema.ParseGroupVersion(getString(obj, "apiVersion"))
+	if kind == "" || err != nil {
+		return ""
+	}
+
+	plural, _ := meta.UnsafeGuessKindToResource(gv.WithKind(kind))
+	if gv.Group == "" {
+		return plural.Resource
+	}
+	return plural.Resource + "." + gv.Group
+}
+
+// GetCurrentContext는 스냅샷이면 기록 당시의 클러스터 이름을, 아니면 덤프 파일/디렉토리 이름을 클러스터로 표시한다
+func (c *Client) GetCurrentContext() (string, string) {
+	if c.discovery != nil && c.discovery.Cluster != "" {
+		return Context, c.discovery.Cluster
+	}
+	return Context, filepath.Base(filepath.Clean(c.path))
+}
+
+func (c *Client) GetAllNamespaces(ctx context.Context) ([]string, error) {
+	namespaces := make([]string, 0, len(c.namespaces))
+	for ns := range c.namespaces {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+func (c *Client) GetResourceTypes(ctx context.Context, namespaced bool) ([]string, error) {
+	if len(c.config.ImportantResourceTypes) > 0 {
+		var important []string
+		for _, resourceType := range c.config.ImportantResourceTypes {
+			if _, ok := c.objects[resourceType]; ok && c.namespaced[resourceType] {
+				important = append(important, resourceType)
+			}
+		}
+		return important, nil
+	}
+
+	return c.resourceTypes(func(resourceType string) bool {
+		return !namespaced || c.namespaced[resourceType]
+	}), nil
+}
+
+// GetClusterResourceTypes는 네임스페이스가 있는 객체가 하나도 없는 타입을 클러스터 범위로 본다
+func (c *Client) GetClusterResourceTypes(ctx context.Context) ([]string, error) {
+	return c.resourceTypes(func(resourceType string) bool {
+		return !c.namespaced[resourceType]
+	}), nil
+}
+
+func (c *Client) resourceTypes(include func(string) bool) []string {
+	var resourceTypes []string
+	for resourceType := range c.objects {
+		if !include(resourceType) || c.isSkipped(resourceType) {
+			continue
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+func (c *Client) isSkipped(resourceType string) bool {
+	plural, _, _ := strings.Cut(resourceType, ".")
+	return c.config.SkipResourceTypes[resourceType] || c.config.SkipResourceTypes[plural]
+}
+
+func (c *Client) GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+	var allResources []map[string]interface{}
+	var failures []*k8sinterface.ResourceTypeError
+
+	for _, resourceType := range resourceTypes {
+		resources, err := c.GetResources(ctx, resourceType, namespace)
+		if err != nil {
+			var rtErr *k8sinterface.ResourceTypeError
+			if !errors.As(err, &rtErr) {
+				rtErr = &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: k8sinterface.FailureError, Err: err}
+			}
+			failures = append(failures, rtErr)
+			continue
+		}
+		allResources = append(allResources, resources...)
+	}
+
+	if len(failures) > 0 {
+		return allResources, &k8sinterface.BatchError{Failures: failures}
+	}
+	return allResources, nil
+}
+
+// GetResources는 namespace가 비어 있으면 API 서버와 마찬가지로 모든 네임스페이스의 객체를 반환한다
+func (c *Client) GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &k8sinterface.ResourceTypeError{ResourceType: resourceType, Reason: k8sinterface.FailureCanceled, Err: err}
+	}
+
+	objects, ok := c.objects[resourceType]
+	if !ok {
+		return nil, &k8sinterface.ResourceTypeError{
+			ResourceType: resourceType,
+			Reason:       k8sinterface.FailureNotFound,
+			Err:          fmt.Errorf("덤프에 없는 리소스 타입: %s", resourceType),
+		}
+	}
+
+	var resources []map[string]interface{}
+	for _, obj := range objects {
+		if namespace != "" {
+			metadata, _ := obj["metadata"].(map[string]interface{})
+			if getString(metadata, "namespace") != namespace {
+				continue
+			}
+		}
+		resources = append(resources, obj)
+	}
+	return resources, nil
+}
+
+func (c *Client) ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error) {
+	result := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		result[ns] = c.namespaces[ns]
+	}
+	return result, nil
+}
+
+func getString(m map[string]interface{}, key string) string {
+	if v, ok := m[key].(string); ok {
+		return v
+	}
+	return ""
+}
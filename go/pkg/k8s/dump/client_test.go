@@ -0,0 +1,140 @@
+package dump
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+)
+
+const kubectlListDump = `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: settings
+    namespace: app
+    uid: cm-1
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: web
+    namespace: app
+    uid: deploy-1
+- apiVersion: rbac.authorization.k8s.io/v1
+  kind: ClusterRole
+  metadata:
+    name: reader
+    uid: cr-1
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: empty
+  uid: ns-1
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewClient_KubectlListDump(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cluster.yaml")
+	writeFile(t, path, kubectlListDump)
+
+	c, err := NewClient(path, &ClientConfig{})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	namespaces, _ := c.GetAllNamespaces(ctx)
+	if want := []string{"app", "empty"}; !reflect.DeepEqual(namespaces, want) {
+		t.Errorf("GetAllNamespaces() = %v, want %v", namespaces, want)
+	}
+
+	namespaced, _ := c.GetResourceTypes(ctx, true)
+	if want := []string{"configmaps", "deployments.apps"}; !reflect.DeepEqual(namespaced, want) {
+		t.Errorf("GetResourceTypes(true) = %v, want %v", namespaced, want)
+	}
+
+	cluster, _ := c.GetClusterResourceTypes(ctx)
+	if want := []string{"clusterroles.rbac.authorization.k8s.io", "namespaces"}; !reflect.DeepEqual(cluster, want) {
+		t.Errorf("GetClusterResourceTypes() = %v, want %v", cluster, want)
+	}
+
+	resources, err := c.GetResourcesBatch(ctx, []string{"configmaps", "deployments.apps"}, "app")
+	if err != nil || len(resources) != 2 {
+		t.Errorf("GetResourcesBatch() = %d개, %v, want 2개", len(resources), err)
+	}
+
+	if kubeContext, cluster := c.GetCurrentContext(); kubeContext != Context || cluster != "cluster.yaml" {
+		t.Errorf("GetCurrentContext() = %v, %v", kubeContext, cluster)
+	}
+}
+
+func TestNewClient_DirectoryTree(t *testing.T) {
+	dir := t.TempDir()
+	deployment := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"app","uid":"deploy-1"}}`
+	// Velero 백업처럼 같은 객체가 여러 경로에 있어도 한 번만 읽어야 한다
+	writeFile(t, filepath.Join(dir, "resources/deployments.apps/namespaces/app/web.json"), deployment)
+	writeFile(t, filepath.Join(dir, "resources/deployments.apps/v1-preferredversion/namespaces/app/web.json"), deployment)
+	writeFile(t, filepath.Join(dir, "resources/storageclasses.storage.k8s.io/cluster/fast.json"),
+		`{"apiVersion":"storage.k8s.io/v1","kind":"StorageClass","metadata":{"name":"fast","uid":"sc-1"}}`)
+	writeFile(t, filepath.Join(dir, "metadata/version"), "1")
+
+	c, err := NewClient(dir, &ClientConfig{SkipResourceTypes: map[string]bool{"storageclasses.storage.k8s.io": true}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	resources, _ := c.GetResources(ctx, "deployments.apps", "app")
+	if len(resources) != 1 {
+		t.Errorf("중복 객체가 제거되지 않았습니다: %d개", len(resources))
+	}
+
+	cluster, _ := c.GetClusterResourceTypes(ctx)
+	if len(cluster) != 0 {
+		t.Errorf("스킵 대상 타입이 반환되었습니다: %v", cluster)
+	}
+	all, _ := c.GetResources(ctx, "storageclasses.storage.k8s.io", "")
+	if len(all) != 1 {
+		t.Errorf("클러스터 범위 조회 = %d개, want 1", len(all))
+	}
+}
+
+func TestClient_GetResourcesUnknownType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cluster.yaml")
+	writeFile(t, path, kubectlListDump)
+	c, err := NewClient(path, &ClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetResources(context.Background(), "applications.argoproj.io", "argocd")
+	var rtErr *k8sinterface.ResourceTypeError
+	if !errors.As(err, &rtErr) || rtErr.Reason != k8sinterface.FailureNotFound {
+		t.Errorf("덤프에 없는 타입은 not_found 에러를 반환해야 합니다: %v", err)
+	}
+}
+
+func TestNewClient_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.yaml")
+	writeFile(t, path, "kind: [unterminated")
+
+	if _, err := NewClient(path, &ClientConfig{}); err == nil {
+		t.Error("파싱할 수 없는 파일에서 에러를 반환해야 합니다")
+	}
+}
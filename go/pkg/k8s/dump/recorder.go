@@ -0,0 +1,314 @@
+package dump
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.bellsoft.net/devops/sre-workbench/go/pkg/k8s/interface"
+)
+
+const (
+	// 스냅샷 아카이브 안의 경로. objects/<리소스 타입>.json은 해당 타입의 객체를 담은 List 문서이다.
+	discoveryFile    = "discovery.json"
+	objectsDir       = "objects"
+	snapshotFileTime = "20060102_150405"
+
+	// RedactedValue는 스냅샷에 저장할 때 Secret 값 대신 기록하는 문자열이다
+	RedactedValue         = "REDACTED"
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Discovery는 스냅샷에 함께 저장하는 조회 당시의 리소스 타입/네임스페이스 목록이다.
+// 재분석 시 객체가 없던 타입까지 원래 스캔과 같은 타입 목록을 사용하기 위해 필요하다.
+type Discovery struct {
+	Context                 string    `json:"context"`
+	Cluster                 string    `json:"cluster"`
+	RecordedAt              time.Time `json:"recordedAt"`
+	Namespaces              []string  `json:"namespaces"`
+	NamespacedResourceTypes []string  `json:"namespacedResourceTypes"`
+	ClusterResourceTypes    []string  `json:"clusterResourceTypes"`
+}
+
+// Recorder는 다른 K8sClient를 감싸 조회한 객체와 리소스 타입 목록을 기록한다
+type Recorder struct {
+	k8sinterface.K8sClient
+
+	mu              sync.Mutex
+	objects         map[string]map[string]map[string]interface{} // resourceType -> 객체 키 -> 객체
+	namespaces      map[string]bool
+	namespacedTypes map[string]bool
+	clusterTypes    map[string]bool
+	// keepSecretData가 false(기본)이면 Secret의 data/stringData 값을 지우고 저장한다
+	keepSecretData bool
+}
+
+func NewRecorder(client k8sinterface.K8sClient) *Recorder {
+	return &Recorder{
+		K8sClient:       client,
+		objects:         make(map[string]map[string]map[string]interface{}),
+		namespaces:      make(map[string]bool),
+		namespacedTypes: make(map[string]bool),
+		clusterTypes:    make(map[string]bool),
+	}
+}
+
+// SetKeepSecretData는 Secret 값을 지우지 않고 그대로 저장할지 설정한다.
+// 분석에는 Secret 값이 필요 없으므로 재분석 용도라면 켤 필요가 없다.
+func (r *Recorder) SetKeepSecretData(keep bool) {
+	r.keepSecretData = keep
+}
+
+func (r *Recorder) KeepsSecretData() bool {
+	return r.keepSecretData
+}
+
+// DiscoveryFailures는 감싼 클라이언트의 discovery 실패를 그대로 전달한다
+func (r *Recorder) DiscoveryFailures() []*k8sinterface.ResourceTypeError {
+	if reporter, ok := r.K8sClient.(k8sinterface.DiscoveryFailureReporter); ok {
+		return reporter.DiscoveryFailures()
+	}
+	return nil
+}
+
+func (r *Recorder) GetAllNamespaces(ctx context.Context) ([]string, error) {
+	namespaces, err := r.K8sClient.GetAllNamespaces(ctx)
+	if err == nil {
+		r.mu.Lock()
+		for _, ns := range namespaces {
+			r.namespaces[ns] = true
+		}
+		r.mu.Unlock()
+	}
+	return namespaces, err
+}
+
+func (r *Recorder) ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error) {
+	result, err := r.K8sClient.ValidateNamespacesBatch(ctx, namespaces)
+	if err == nil {
+		r.mu.Lock()
+		for ns, exists := range result {
+			if exists {
+				r.namespaces[ns] = true
+			}
+		}
+		r.mu.Unlock()
+	}
+	return result, err
+}
+
+func (r *Recorder) GetResourceTypes(ctx context.Context, namespaced bool) ([]string, error) {
+	resourceTypes, err := r.K8sClient.GetResourceTypes(ctx, namespaced)
+	if err == nil {
+		r.mu.Lock()
+		for _, resourceType := range resourceTypes {
+			r.namespacedTypes[resourceType] = true
+		}
+		r.mu.Unlock()
+	}
+	return resourceTypes, err
+}
+
+func (r *Recorder) GetClusterResourceTypes(ctx context.Context) ([]string, error) {
+	resourceTypes, err := r.K8sClient.GetClusterResourceTypes(ctx)
+	if err == nil {
+		r.mu.Lock()
+		for _, resourceType := range resourceTypes {
+			r.clusterTypes[resourceType] = true
+		}
+		r.mu.Unlock()
+	}
+	return resourceTypes, err
+}
+
+// GetResourcesBatch는 객체가 어떤 타입으로 조회되었는지 알 수 없으므로 apiVersion/kind에서 타입 이름을 추정해 기록한다
+func (r *Recorder) GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error) {
+	resources, err := r.K8sClient.GetResourcesBatch(ctx, resourceTypes, namespace)
+	r.record("", resources)
+	return resources, err
+}
+
+func (r *Recorder) GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error) {
+	resources, err := r.K8sClient.GetResources(ctx, resourceType, namespace)
+	r.record(resourceType, resources)
+	return resources, err
+}
+
+func (r *Recorder) record(resourceType string, resources []map[string]interface{}) {
+	if len(resources) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, obj := range resources {
+		recordType := resourceType
+		if recordType == "" {
+			recordType = resourceTypeOf(obj)
+		}
+		if recordType == "" {
+			continue
+		}
+		if r.objects[recordType] == nil {
+			r.objects[recordType] = make(map[string]map[string]interface{})
+		}
+		if !r.keepSecretData {
+			obj = redactSecret(obj)
+		}
+		r.objects[recordType][objectKey(recordType, obj)] = obj
+	}
+}
+
+// redactSecret은 Secret의 값을 지운 사본을 반환한다. 키 목록은 남긴다.
+// 조회 결과는 분석기와 매니페스트 내보내기도 사용하므로 원본은 변경하지 않는다.
+func redactSecret(obj map[string]interface{}) map[string]interface{} {
+	if getString(obj, "kind") != "Secret" || getString(obj, "apiVersion") != "v1" {
+		return obj
+	}
+
+	redacted := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		redacted[key] = value
+	}
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := obj[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		masked := make(map[string]interface{}, len(values))
+		for key := range values {
+			masked[key] = RedactedValue
+		}
+		redacted[field] = masked
+	}
+
+	// kubectl apply로 만든 Secret은 last-applied-configuration 어노테이션에도 값이 평문으로 남아 있다
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if _, ok := annotations[lastAppliedAnnotation]; ok {
+		maskedAnnotations := make(map[string]interface{}, len(annotations))
+		for key, value := range annotations {
+			maskedAnnotations[key] = value
+		}
+		maskedAnnotations[lastAppliedAnnotation] = RedactedValue
+
+		maskedMetadata := make(map[string]interface{}, len(metadata))
+		for key, value := range metadata {
+			maskedMetadata[key] = value
+		}
+		maskedMetadata["annotations"] = maskedAnnotations
+		redacted["metadata"] = maskedMetadata
+	}
+	return redacted
+}
+
+// WriteArchive는 기록한 내용을 dir 아래 argus-snapshot_<시각>.tar.gz로 저장하고 파일 경로를 반환한다
+func (r *Recorder) WriteArchive(dir string, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("스냅샷 디렉토리 생성 실패: %w", err)
+	}
+
+	filename := filepath.Join(dir, fmt.Sprintf("argus-snapshot_%s.tar.gz", now.Format(snapshotFileTime)))
+	// 클러스터 객체가 그대로 들어 있으므로 소유자만 읽을 수 있게 만든다
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", fmt.Errorf("스냅샷 파일 생성 실패: %w", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	if err := r.writeEntries(tw, now); err != nil {
+		return "", fmt.Errorf("스냅샷 저장 실패: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return "", fmt.Errorf("스냅샷 저장 실패: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("스냅샷 저장 실패: %w", err)
+	}
+	return filename, nil
+}
+
+func (r *Recorder) writeEntries(tw *tar.Writer, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kubeContext, cluster := r.K8sClient.GetCurrentContext()
+	discovery := Discovery{
+		Context:                 kubeContext,
+		Cluster:                 cluster,
+		RecordedAt:              now,
+		Namespaces:              sortedKeys(r.namespaces),
+		NamespacedResourceTypes: sortedKeys(r.namespacedTypes),
+		ClusterResourceTypes:    sortedKeys(r.clusterTypes),
+	}
+	if err := writeJSONEntry(tw, discoveryFile, discovery, now); err != nil {
+		return err
+	}
+
+	resourceTypes := make([]string, 0, len(r.objects))
+	for resourceType := range r.objects {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		byKey := r.objects[resourceType]
+		keys := make([]string, 0, len(byKey))
+		for key := range byKey {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		items := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, byKey[key])
+		}
+		list := map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": items}
+		if err := writeJSONEntry(tw, objectsDir+"/"+resourceType+".json", list, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSONEntry(tw *tar.Writer, name string, v interface{}, modTime time.Time) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	header := &tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: modTime}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+func objectKey(resourceType string, obj map[string]interface{}) string {
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	if uid := getString(metadata, "uid"); uid != "" {
+		return uid
+	}
+	return strings.Join([]string{resourceType, getString(metadata, "namespace"), getString(metadata, "name")}, "/")
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
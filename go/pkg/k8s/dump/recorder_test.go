@@ -0,0 +1,132 @@
+package dump
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecorder_WriteArchiveReplay(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "cluster.yaml")
+	writeFile(t, source, kubectlListDump)
+	origin, err := NewClient(source, &ClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := NewRecorder(origin)
+	ctx := context.Background()
+	if _, err := recorder.GetAllNamespaces(ctx); err != nil {
+		t.Fatal(err)
+	}
+	namespacedTypes, _ := recorder.GetResourceTypes(ctx, true)
+	clusterTypes, _ := recorder.GetClusterResourceTypes(ctx)
+	// configmaps만 조회한 경우에도 조회하지 않은 타입 목록은 그대로 재현되어야 한다
+	if _, err := recorder.GetResourcesBatch(ctx, []string{"configmaps"}, "app"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.GetResources(ctx, "clusterroles.rbac.authorization.k8s.io", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	recordedAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	archive, err := recorder.WriteArchive(filepath.Join(t.TempDir(), "snapshots"), recordedAt)
+	if err != nil {
+		t.Fatalf("WriteArchive() error = %v", err)
+	}
+	if filepath.Base(archive) != "argus-snapshot_20240601_090000.tar.gz" {
+		t.Errorf("아카이브 파일명 = %v", filepath.Base(archive))
+	}
+	if info, err := os.Stat(archive); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("아카이브 권한 = %v, %v, want 0600", info.Mode().Perm(), err)
+	}
+
+	replay, err := NewClient(archive, &ClientConfig{})
+	if err != nil {
+		t.Fatalf("NewClient(archive) error = %v", err)
+	}
+
+	if kubeContext, cluster := replay.GetCurrentContext(); kubeContext != Context || cluster != "cluster.yaml" {
+		t.Errorf("GetCurrentContext() = %v, %v, want 기록 당시 클러스터 이름", kubeContext, cluster)
+	}
+	namespaces, _ := replay.GetAllNamespaces(ctx)
+	if want := []string{"app", "empty"}; !reflect.DeepEqual(namespaces, want) {
+		t.Errorf("GetAllNamespaces() = %v, want %v", namespaces, want)
+	}
+	if got, _ := replay.GetResourceTypes(ctx, true); !reflect.DeepEqual(got, namespacedTypes) {
+		t.Errorf("GetResourceTypes(true) = %v, want %v", got, namespacedTypes)
+	}
+	if got, _ := replay.GetClusterResourceTypes(ctx); !reflect.DeepEqual(got, clusterTypes) {
+		t.Errorf("GetClusterResourceTypes() = %v, want %v", got, clusterTypes)
+	}
+
+	configMaps, _ := replay.GetResources(ctx, "configmaps", "app")
+	if len(configMaps) != 1 || configMaps[0]["metadata"].(map[string]interface{})["name"] != "settings" {
+		t.Errorf("configmaps = %v", configMaps)
+	}
+	deployments, err := replay.GetResources(ctx, "deployments.apps", "app")
+	if err != nil || len(deployments) != 0 {
+		t.Errorf("조회하지 않은 타입은 빈 목록이어야 합니다: %v, %v", deployments, err)
+	}
+	clusterRoles, _ := replay.GetResources(ctx, "clusterroles.rbac.authorization.k8s.io", "")
+	if len(clusterRoles) != 1 {
+		t.Errorf("clusterroles = %d개, want 1", len(clusterRoles))
+	}
+}
+
+func TestRecorder_RedactsSecrets(t *testing.T) {
+	secret := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "db",
+			"namespace": "app",
+			"uid":       "secret-uid",
+			"annotations": map[string]interface{}{
+				lastAppliedAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+				"owner":               "sre",
+			},
+		},
+		"data":       map[string]interface{}{"password": "c2VjcmV0"},
+		"stringData": map[string]interface{}{"user": "admin"},
+	}
+
+	tests := []struct {
+		name string
+		keep bool
+		want string
+	}{
+		{name: "기본값: 값 삭제", want: RedactedValue},
+		{name: "값 유지", keep: true, want: "c2VjcmV0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := NewRecorder(nil)
+			recorder.SetKeepSecretData(tt.keep)
+			recorder.record("secrets", []map[string]interface{}{secret})
+
+			recorded := recorder.objects["secrets"]["secret-uid"]
+			if got := recorded["data"].(map[string]interface{})["password"]; got != tt.want {
+				t.Errorf("data.password = %v, want %v", got, tt.want)
+			}
+			if tt.keep {
+				return
+			}
+			if got := recorded["stringData"].(map[string]interface{})["user"]; got != RedactedValue {
+				t.Errorf("stringData.user = %v, want %v", got, RedactedValue)
+			}
+			annotations := recorded["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+			if annotations[lastAppliedAnnotation] != RedactedValue || annotations["owner"] != "sre" {
+				t.Errorf("annotations = %v", annotations)
+			}
+		})
+	}
+
+	if got := secret["data"].(map[string]interface{})["password"]; got != "c2VjcmV0" {
+		t.Errorf("원본 객체가 변경되었습니다: %v", got)
+	}
+}
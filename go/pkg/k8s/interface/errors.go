@@ -0,0 +1,46 @@
+package k8sinterface
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	FailureForbidden = "forbidden"
+	FailureTimeout   = "timeout"
+	// FailureThrottled는 재시도 후에도 API 서버가 429(API Priority and Fairness 거부 포함)로 응답한 경우이다
+	FailureThrottled = "throttled"
+	// FailureDiscovery는 API 그룹의 discovery에 실패해 그 그룹의 리소스 타입을 알 수 없는 경우이다
+	FailureDiscovery = "discovery_failed"
+	FailureNotFound  = "not_found"
+	FailureCanceled  = "canceled"
+	FailureError     = "error"
+)
+
+type ResourceTypeError struct {
+	ResourceType string
+	Reason       string
+	Err          error
+}
+
+func (e *ResourceTypeError) Error() string {
+	return fmt.Sprintf("%s (%s): %v", e.ResourceType, e.Reason, e.Err)
+}
+
+func (e *ResourceTypeError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError는 배치 중 일부 리소스 타입 조회가 실패했음을 나타낸다.
+// 함께 반환된 리소스 목록은 성공한 타입의 결과만 담고 있다.
+type BatchError struct {
+	Failures []*ResourceTypeError
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		messages = append(messages, failure.Error())
+	}
+	return fmt.Sprintf("%d개 리소스 타입 조회 실패: %s", len(e.Failures), strings.Join(messages, "; "))
+}
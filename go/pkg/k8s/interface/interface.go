@@ -1,10 +1,20 @@
 package k8sinterface
 
+import "context"
+
 type K8sClient interface {
 	GetCurrentContext() (string, string)
-	GetAllNamespaces() ([]string, error)
-	GetResourceTypes(namespaced bool) ([]string, error)
-	GetResourcesBatch(resourceTypes []string, namespace string) ([]map[string]interface{}, error)
-	GetResources(resourceType, namespace string) ([]map[string]interface{}, error)
-	ValidateNamespacesBatch(namespaces []string) (map[string]bool, error)
+	GetAllNamespaces(ctx context.Context) ([]string, error)
+	GetResourceTypes(ctx context.Context, namespaced bool) ([]string, error)
+	// GetClusterResourceTypes는 네임스페이스에 속하지 않는(클러스터 범위) 리소스 타입만 반환한다
+	GetClusterResourceTypes(ctx context.Context) ([]string, error)
+	GetResourcesBatch(ctx context.Context, resourceTypes []string, namespace string) ([]map[string]interface{}, error)
+	GetResources(ctx context.Context, resourceType, namespace string) ([]map[string]interface{}, error)
+	ValidateNamespacesBatch(ctx context.Context, namespaces []string) (map[string]bool, error)
+}
+
+// DiscoveryFailureReporter는 일부 API 그룹의 discovery 실패를 무시하고 계속 진행하는 클라이언트가 구현한다.
+// 반환하는 오류의 ResourceType은 실패한 그룹/버전이다.
+type DiscoveryFailureReporter interface {
+	DiscoveryFailures() []*ResourceTypeError
 }